@@ -49,6 +49,14 @@ const (
 	ApplicationCommandPermissionTypeUser
 )
 
+// ApplicationIntegrationType represents where an application can be installed.
+type ApplicationIntegrationType uint8
+
+const (
+	ApplicationIntegrationTypeGuildInstall ApplicationIntegrationType = iota
+	ApplicationIntegrationTypeUserInstall
+)
+
 // IntegrationType represents the type of integration.
 type IntegrationType string
 
@@ -141,13 +149,13 @@ type ApplicationCommandPermissions struct {
 type ApplicationCommandOption struct {
 	DefaultMemberPermission  *Int64                           `json:"default_member_permissions,omitempty"`
 	DMPermission             *bool                            `json:"dm_permission,omitempty"`
-	MinValue                 *int32                           `json:"min_value,omitempty"`
+	MinValue                 *float64                         `json:"min_value,omitempty"`
 	Autocomplete             *bool                            `json:"autocomplete,omitempty"`
 	NameLocalizations        map[string]string                `json:"name_localizations,omitempty"`
 	MaxLength                *int32                           `json:"max_length,omitempty"`
 	DescriptionLocalizations map[string]string                `json:"description_localizations,omitempty"`
 	MinLength                *int32                           `json:"min_length,omitempty"`
-	MaxValue                 *int32                           `json:"max_value,omitempty"`
+	MaxValue                 *float64                         `json:"max_value,omitempty"`
 	Description              string                           `json:"description,omitempty"`
 	Name                     string                           `json:"name"`
 	ChannelTypes             ChannelTypeList                  `json:"channel_types,omitempty"`
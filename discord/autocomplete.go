@@ -0,0 +1,207 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// autocomplete.go contains the autocomplete handler framework for the CommandRouter.
+
+const (
+	// MaxAutocompleteChoices is the maximum number of choices discord accepts in an autocomplete result.
+	MaxAutocompleteChoices = 25
+
+	// MaxChoiceNameLength is the maximum length of a choice name.
+	MaxChoiceNameLength = 100
+
+	// MaxChoiceStringValueLength is the maximum length of a string choice value.
+	MaxChoiceStringValueLength = 100
+)
+
+// AutocompleteHandler returns the choices for the focused option of an autocomplete interaction.
+type AutocompleteHandler func(ctx context.Context, interaction *Interaction, input AutocompleteInput) ([]ApplicationCommandOptionChoice, error)
+
+// AutocompleteInput represents the focused option of an autocomplete interaction.
+type AutocompleteInput struct {
+	// Focused is the option currently being typed in.
+	Focused *InteractionDataOption
+
+	// Value is the partial input of the focused option.
+	Value string
+
+	// Args contains the other options passed so far. Values have not been validated by discord.
+	Args CommandArgs
+}
+
+// RegisterAutocomplete registers an autocomplete handler for an option of a command path.
+// path: The command path, for example "/settings welcome channel".
+// optionName: The name of the option with autocomplete enabled.
+// handler: The handler that provides the choices.
+func (cr *CommandRouter) RegisterAutocomplete(path, optionName string, handler AutocompleteHandler) *CommandRouter {
+	cr.mu.Lock()
+	cr.autocomplete[autocompleteKey(normalizeCommandPath(path), optionName)] = handler
+	cr.mu.Unlock()
+
+	return cr
+}
+
+func autocompleteKey(path, optionName string) string {
+	return path + "\x00" + optionName
+}
+
+func (cr *CommandRouter) handleAutocomplete(ctx context.Context, interaction *Interaction) (*InteractionResponse, error) {
+	path, options := interaction.Data.CommandPath()
+
+	args := CommandArgs{
+		Options:  options,
+		Resolved: interaction.Data.Resolved,
+	}
+
+	focused, ok := args.Focused()
+	if !ok {
+		return nil, fmt.Errorf("%w: no focused option for %s", ErrUnknownCommand, path)
+	}
+
+	cr.mu.RLock()
+	handler, ok := cr.autocomplete[autocompleteKey(path, focused.Name)]
+	cr.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: no autocomplete for %s %s", ErrUnknownCommand, path, focused.Name)
+	}
+
+	input := AutocompleteInput{
+		Focused: focused,
+		Value:   autocompleteValue(focused.Value),
+		Args:    args,
+	}
+
+	choices, err := handler(ctx, interaction, input)
+	if err != nil {
+		return nil, err
+	}
+
+	choices, err = LimitAutocompleteChoices(choices, focused.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InteractionResponse{
+		Type: InteractionCallbackTypeAutocompleteResult,
+		Data: &InteractionCallbackData{
+			Choices: choices,
+		},
+	}, nil
+}
+
+// autocompleteValue returns the partial input of a focused option. Discord sends the partial
+// input as a string, even for integer and number options.
+func autocompleteValue(raw json.RawMessage) string {
+	var value string
+
+	if json.Unmarshal(raw, &value) == nil {
+		return value
+	}
+
+	return string(raw)
+}
+
+// Focused returns the option that is focused in an autocomplete interaction.
+func (ca CommandArgs) Focused() (*InteractionDataOption, bool) {
+	for i := range ca.Options {
+		if ca.Options[i].Focused {
+			return &ca.Options[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// NewStringChoice creates a choice for a string option.
+func NewStringChoice(name, value string) ApplicationCommandOptionChoice {
+	marshaled, _ := json.Marshal(value)
+
+	return ApplicationCommandOptionChoice{
+		Name:  name,
+		Value: marshaled,
+	}
+}
+
+// NewIntegerChoice creates a choice for an integer option.
+func NewIntegerChoice(name string, value int64) ApplicationCommandOptionChoice {
+	return ApplicationCommandOptionChoice{
+		Name:  name,
+		Value: json.RawMessage(strconv.FormatInt(value, 10)),
+	}
+}
+
+// NewNumberChoice creates a choice for a number option.
+func NewNumberChoice(name string, value float64) ApplicationCommandOptionChoice {
+	return ApplicationCommandOptionChoice{
+		Name:  name,
+		Value: json.RawMessage(strconv.FormatFloat(value, 'f', -1, 64)),
+	}
+}
+
+// LimitAutocompleteChoices enforces discord's autocomplete limits. Only the first 25 choices
+// are kept and choice names longer than 100 characters are truncated. Choice values must match
+// the option type, otherwise ErrInvalidChoiceValue is returned.
+func LimitAutocompleteChoices(choices []ApplicationCommandOptionChoice, optionType ApplicationCommandOptionType) ([]ApplicationCommandOptionChoice, error) {
+	if len(choices) > MaxAutocompleteChoices {
+		choices = choices[:MaxAutocompleteChoices]
+	}
+
+	limited := make([]ApplicationCommandOptionChoice, len(choices))
+
+	for i, choice := range choices {
+		choice.Name = TruncateString(choice.Name, MaxChoiceNameLength)
+
+		switch optionType {
+		case ApplicationCommandOptionTypeString:
+			var value string
+
+			if json.Unmarshal(choice.Value, &value) != nil {
+				return nil, fmt.Errorf("%w: choice %q is not a string", ErrInvalidChoiceValue, choice.Name)
+			}
+
+			if utf8.RuneCountInString(value) > MaxChoiceStringValueLength {
+				return nil, fmt.Errorf("%w: choice %q value exceeds %d characters", ErrInvalidChoiceValue, choice.Name, MaxChoiceStringValueLength)
+			}
+		case ApplicationCommandOptionTypeInteger:
+			var value int64
+
+			if json.Unmarshal(choice.Value, &value) != nil {
+				return nil, fmt.Errorf("%w: choice %q is not an integer", ErrInvalidChoiceValue, choice.Name)
+			}
+		case ApplicationCommandOptionTypeNumber:
+			var value float64
+
+			if json.Unmarshal(choice.Value, &value) != nil {
+				return nil, fmt.Errorf("%w: choice %q is not a number", ErrInvalidChoiceValue, choice.Name)
+			}
+		}
+
+		limited[i] = choice
+	}
+
+	return limited, nil
+}
+
+// TruncateString truncates a string to at most limit characters without splitting a
+// character. Truncated strings end with an ellipsis.
+func TruncateString(value string, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+
+	runes := []rune(value)
+
+	return string(runes[:limit-1]) + "…"
+}
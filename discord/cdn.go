@@ -0,0 +1,276 @@
+package discord
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// cdn.go contains helpers for building CDN URLs for avatars, icons, emojis and other images.
+
+// ImageFormat represents the format of an image on the CDN.
+type ImageFormat string
+
+const (
+	ImageFormatPNG    ImageFormat = "png"
+	ImageFormatJPEG   ImageFormat = "jpg"
+	ImageFormatWebP   ImageFormat = "webp"
+	ImageFormatGIF    ImageFormat = "gif"
+	ImageFormatAVIF   ImageFormat = "avif"
+	ImageFormatLottie ImageFormat = "json"
+)
+
+// Image sizes.
+const (
+	MinImageSize = 16
+	MaxImageSize = 4096
+)
+
+const (
+	animatedHashPrefix   = "a_"
+	defaultAvatarsLegacy = 5
+	defaultAvatarsCount  = 6
+)
+
+// ImageOptions represents the options used to build a CDN URL.
+type ImageOptions struct {
+	// Format is the image format. If empty, animated images use GIF and others use PNG.
+	Format ImageFormat
+
+	// Size is the requested width of the image. Must be a power of two between 16 and 4096.
+	// If zero, the original size is used.
+	Size int
+
+	// Static requests the first frame of an animated image.
+	Static bool
+}
+
+// Validate checks the format and size of the options. URL builders do not use invalid options,
+// so Validate should be called first when options come from user input.
+func (o ImageOptions) Validate() error {
+	if !o.validFormat() {
+		return ErrInvalidImageFormat
+	}
+
+	if !o.validSize() {
+		return ErrInvalidImageSize
+	}
+
+	return nil
+}
+
+func (o ImageOptions) validFormat() bool {
+	switch o.Format {
+	case "", ImageFormatPNG, ImageFormatJPEG, ImageFormatWebP, ImageFormatGIF, ImageFormatAVIF:
+		return true
+	default:
+		return false
+	}
+}
+
+func (o ImageOptions) validSize() bool {
+	return o.Size == 0 || (o.Size >= MinImageSize && o.Size <= MaxImageSize && o.Size&(o.Size-1) == 0)
+}
+
+// IsAnimatedHash returns if an image hash refers to an animated image.
+func IsAnimatedHash(hash string) bool {
+	return strings.HasPrefix(hash, animatedHashPrefix)
+}
+
+// imageURL returns the CDN URL of an image.
+// path: The path to the image without an extension, such as "/avatars/{user_id}/{hash}".
+// animated: If the image is animated.
+func imageURL(path string, animated bool, options ImageOptions) string {
+	return hostImageURL(EndpointCDN, path, animated, options)
+}
+
+// hostImageURL returns the URL of an image on a host. Options that fail Validate are not used: an
+// invalid format falls back to the default format and an invalid size to the original size.
+func hostImageURL(host, path string, animated bool, options ImageOptions) string {
+	animated = animated && !options.Static
+
+	format := options.Format
+	if !options.validFormat() {
+		format = ""
+	}
+
+	if format == "" {
+		format = ImageFormatPNG
+
+		if animated {
+			format = ImageFormatGIF
+		}
+	} else if format == ImageFormatGIF && !animated {
+		format = ImageFormatPNG
+	}
+
+	query := url.Values{}
+
+	if options.Size > 0 && options.validSize() {
+		query.Set("size", strconv.Itoa(options.Size))
+	}
+
+	if animated && format == ImageFormatWebP {
+		query.Set("animated", "true")
+	}
+
+	imageURL := host + path + "." + string(format)
+
+	if len(query) > 0 {
+		imageURL += "?" + query.Encode()
+	}
+
+	return imageURL
+}
+
+// hashURL returns the CDN URL of an image identified by a hash, or an empty string if there is no hash.
+func hashURL(prefix string, id Snowflake, hash string, options ImageOptions) string {
+	if hash == "" {
+		return ""
+	}
+
+	return imageURL(prefix+id.String()+"/"+hash, IsAnimatedHash(hash), options)
+}
+
+// DefaultAvatarIndex returns the index of the default avatar for a user. Users on the new username
+// system have a discriminator of "0" and their avatar is based on their ID.
+func DefaultAvatarIndex(userID Snowflake, discriminator string) int {
+	legacy, err := strconv.Atoi(discriminator)
+	if err != nil || legacy == 0 {
+		return int((uint64(userID) >> 22) % defaultAvatarsCount)
+	}
+
+	return legacy % defaultAvatarsLegacy
+}
+
+// DefaultAvatarURL returns the URL of the user's default avatar.
+func (u *User) DefaultAvatarURL() string {
+	return EndpointCDN + EndpointCDNDefaultAvatars + strconv.Itoa(DefaultAvatarIndex(u.ID, u.Discriminator)) + ".png"
+}
+
+// AvatarURL returns the URL of the user's avatar, or their default avatar if they have none.
+func (u *User) AvatarURL(options ImageOptions) string {
+	if u.Avatar == "" {
+		return u.DefaultAvatarURL()
+	}
+
+	return hashURL(EndpointCDNAvatars, u.ID, u.Avatar, options)
+}
+
+// BannerURL returns the URL of the user's banner, or an empty string if they have none.
+func (u *User) BannerURL(options ImageOptions) string {
+	return hashURL(EndpointCDNBanners, u.ID, u.Banner, options)
+}
+
+// AvatarDecorationURL returns the URL of the user's avatar decoration, or an empty string if they have none.
+func (u *User) AvatarDecorationURL(options ImageOptions) string {
+	if u.AvatarDecorationData == nil || u.AvatarDecorationData.Asset == "" {
+		return ""
+	}
+
+	// Avatar decorations are served as animated PNGs, so are never treated as animated GIFs.
+	return imageURL(EndpointCDNAvatarDecorations+u.AvatarDecorationData.Asset, false, options)
+}
+
+// AvatarURL returns the URL of the member's guild avatar, falling back to their user avatar.
+func (gm *GuildMember) AvatarURL(guildID Snowflake, options ImageOptions) string {
+	if gm.Avatar == "" || gm.User == nil {
+		if gm.User == nil {
+			return ""
+		}
+
+		return gm.User.AvatarURL(options)
+	}
+
+	return imageURL(EndpointGuilds+guildID.String()+EndpointUsers+gm.User.ID.String()+EndpointCDNAvatars+gm.Avatar, IsAnimatedHash(gm.Avatar), options)
+}
+
+// BannerURL returns the URL of the member's guild banner, falling back to their user banner.
+func (gm *GuildMember) BannerURL(guildID Snowflake, options ImageOptions) string {
+	if gm.Banner == "" || gm.User == nil {
+		if gm.User == nil {
+			return ""
+		}
+
+		return gm.User.BannerURL(options)
+	}
+
+	return imageURL(EndpointGuilds+guildID.String()+EndpointUsers+gm.User.ID.String()+EndpointCDNBanners+gm.Banner, IsAnimatedHash(gm.Banner), options)
+}
+
+// IconURL returns the URL of the guild's icon, or an empty string if it has none.
+func (g *Guild) IconURL(options ImageOptions) string {
+	return hashURL(EndpointCDNIcons, g.ID, g.Icon, options)
+}
+
+// BannerURL returns the URL of the guild's banner, or an empty string if it has none.
+func (g *Guild) BannerURL(options ImageOptions) string {
+	return hashURL(EndpointCDNBanners, g.ID, g.Banner, options)
+}
+
+// SplashURL returns the URL of the guild's invite splash, or an empty string if it has none.
+func (g *Guild) SplashURL(options ImageOptions) string {
+	return hashURL(EndpointCDNSplashes, g.ID, g.Splash, options)
+}
+
+// DiscoverySplashURL returns the URL of the guild's discovery splash, or an empty string if it has none.
+func (g *Guild) DiscoverySplashURL(options ImageOptions) string {
+	return hashURL(EndpointCDNDiscoverySplashes, g.ID, g.DiscoverySplash, options)
+}
+
+// IconURL returns the URL of a group DM's icon, or an empty string if it has none.
+func (c *Channel) IconURL(options ImageOptions) string {
+	return hashURL(EndpointCDNChannelIcons, c.ID, c.Icon, options)
+}
+
+// IconURL returns the URL of the role's icon, or an empty string if it has none.
+func (r *Role) IconURL(options ImageOptions) string {
+	return hashURL(EndpointCDNRoleIcons, r.ID, r.Icon, options)
+}
+
+// URL returns the URL of a custom emoji, or an empty string for unicode emojis.
+func (e *Emoji) URL(options ImageOptions) string {
+	if e.ID.IsNil() {
+		return ""
+	}
+
+	return imageURL(EndpointCDNEmojis+e.ID.String(), e.Animated, options)
+}
+
+// URL returns the URL of the sticker. Lottie stickers are returned as JSON and ignore the options.
+func (s *Sticker) URL(options ImageOptions) string {
+	switch s.FormatType {
+	case StickerFormatTypeLOTTIE:
+		return EndpointCDN + EndpointCDNStickers + s.ID.String() + "." + string(ImageFormatLottie)
+	case StickerFormatTypeGIF:
+		// GIF stickers are only served from the media proxy.
+		return hostImageURL(EndpointMedia, EndpointCDNStickers+s.ID.String(), true, options)
+	default:
+		// APNG stickers are served as PNG.
+		return imageURL(EndpointCDNStickers+s.ID.String(), false, options)
+	}
+}
+
+// CoverImageURL returns the URL of the scheduled event's cover image, or an empty string if it has none.
+func (se *ScheduledEvent) CoverImageURL(options ImageOptions) string {
+	if se.Image == nil {
+		return ""
+	}
+
+	return hashURL(EndpointCDNGuildScheduledEvent, se.ID, *se.Image, options)
+}
+
+// IconURL returns the URL of the application's icon, or an empty string if it has none.
+func (a *Application) IconURL(options ImageOptions) string {
+	return hashURL(EndpointCDNApplicationIcons, a.ID, a.Icon, options)
+}
+
+// CoverImageURL returns the URL of the application's cover image, or an empty string if it has none.
+func (a *Application) CoverImageURL(options ImageOptions) string {
+	return hashURL(EndpointCDNApplicationIcons, a.ID, a.CoverImage, options)
+}
+
+// IconURL returns the URL of the team's icon, or an empty string if it has none.
+func (at *ApplicationTeam) IconURL(options ImageOptions) string {
+	return hashURL(EndpointCDNTeamIcons, at.ID, at.Icon, options)
+}
@@ -61,7 +61,7 @@ type Channel struct {
 	ParentID                      *Snowflake            `json:"parent_id,omitempty"`
 	ApplicationID                 *Snowflake            `json:"application_id,omitempty"`
 	DefaultReactionEmoji          *DefaultReactionEmoji `json:"default_reaction_emoji,omitempty"`
-	DefaultSortOrder              *int32                `json:"default_sort_order,omitempty"`
+	DefaultSortOrder              *ForumSortOrder       `json:"default_sort_order,omitempty"`
 	HDStreamingBuyerID            *Snowflake            `json:"hd_streaming_buyer_id,omitempty"`
 	RTCRegion                     string                `json:"rtc_region,omitempty"`
 	Topic                         string                `json:"topic,omitempty"`
@@ -72,6 +72,7 @@ type Channel struct {
 	PermissionOverwrites          ChannelOverwriteList  `json:"permission_overwrites,omitempty"`
 	Recipients                    UserList              `json:"recipients,omitempty"`
 	AvailableTags                 []ForumTag            `json:"available_tags,omitempty"`
+	AppliedTags                   []Snowflake           `json:"applied_tags,omitempty"`
 	ID                            Snowflake             `json:"id"`
 	UserLimit                     int32                 `json:"user_limit,omitempty"`
 	Bitrate                       int32                 `json:"bitrate,omitempty"`
@@ -81,24 +82,84 @@ type Channel struct {
 	Position                      int32                 `json:"position,omitempty"`
 	DefaultAutoArchiveDuration    int32                 `json:"default_auto_archive_duration,omitempty"`
 	DefaultThreadRateLimitPerUser int32                 `json:"default_thread_rate_limit_per_user,omitempty"`
-	DefaultForumLayout            int32                 `json:"default_forum_layout,omitempty"`
+	DefaultForumLayout            ForumLayout           `json:"default_forum_layout,omitempty"`
 	Flags                         int32                 `json:"flags,omitempty"`
 	NSFW                          bool                  `json:"nsfw"`
 	Type                          ChannelType           `json:"type"`
 }
 
-// ChannelParams the data that is provided when creating a channel.
-type ChannelParams struct {
-	ParentID             *Snowflake           `json:"parent_id,omitempty"`
-	Name                 string               `json:"name"`
-	Topic                string               `json:"topic,omitempty"`
-	PermissionOverwrites ChannelOverwriteList `json:"permission_overwrites,omitempty"`
-	Bitrate              int32                `json:"bitrate,omitempty"`
-	UserLimit            int32                `json:"user_limit,omitempty"`
-	RateLimitPerUser     int32                `json:"rate_limit_per_user,omitempty"`
-	Position             int32                `json:"position,omitempty"`
-	Type                 ChannelType          `json:"type"`
-	NSFW                 bool                 `json:"nsfw"`
+// CreateChannelParams represents the parameters for creating a guild channel.
+type CreateChannelParams struct {
+	ParentID                      *Snowflake            `json:"parent_id,omitempty"`
+	VideoQualityMode              *VideoQualityMode     `json:"video_quality_mode,omitempty"`
+	DefaultAutoArchiveDuration    *int32                `json:"default_auto_archive_duration,omitempty"`
+	DefaultReactionEmoji          *DefaultReactionEmoji `json:"default_reaction_emoji,omitempty"`
+	DefaultSortOrder              *ForumSortOrder       `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            *ForumLayout          `json:"default_forum_layout,omitempty"`
+	DefaultThreadRateLimitPerUser *int32                `json:"default_thread_rate_limit_per_user,omitempty"`
+	Name                          string                `json:"name"`
+	Topic                         string                `json:"topic,omitempty"`
+	RTCRegion                     string                `json:"rtc_region,omitempty"`
+	PermissionOverwrites          ChannelOverwriteList  `json:"permission_overwrites,omitempty"`
+	AvailableTags                 []ForumTagParams      `json:"available_tags,omitempty"`
+	Bitrate                       int32                 `json:"bitrate,omitempty"`
+	UserLimit                     int32                 `json:"user_limit,omitempty"`
+	RateLimitPerUser              int32                 `json:"rate_limit_per_user,omitempty"`
+	Position                      int32                 `json:"position,omitempty"`
+	Type                          ChannelType           `json:"type"`
+	NSFW                          bool                  `json:"nsfw"`
+}
+
+// Validate checks the available tags of a forum or media channel are within Discord's limits.
+func (cp CreateChannelParams) Validate() error {
+	return validateAvailableTags(cp.AvailableTags)
+}
+
+// ModifyChannelParams represents the parameters for modifying a channel or thread. Unset fields
+// are left unchanged, and nullable fields can be cleared with Null, such as ParentID to move a
+// channel out of its category.
+type ModifyChannelParams struct {
+	Name                          Optional[string]               `json:"name,omitempty"`
+	Type                          Optional[ChannelType]          `json:"type,omitempty"`
+	Position                      Nullable[int32]                `json:"position,omitempty"`
+	Topic                         Nullable[string]               `json:"topic,omitempty"`
+	NSFW                          Nullable[bool]                 `json:"nsfw,omitempty"`
+	RateLimitPerUser              Nullable[int32]                `json:"rate_limit_per_user,omitempty"`
+	Bitrate                       Nullable[int32]                `json:"bitrate,omitempty"`
+	UserLimit                     Nullable[int32]                `json:"user_limit,omitempty"`
+	PermissionOverwrites          Nullable[ChannelOverwriteList] `json:"permission_overwrites,omitempty"`
+	ParentID                      Nullable[Snowflake]            `json:"parent_id,omitempty"`
+	RTCRegion                     Nullable[string]               `json:"rtc_region,omitempty"`
+	VideoQualityMode              Nullable[VideoQualityMode]     `json:"video_quality_mode,omitempty"`
+	DefaultAutoArchiveDuration    Nullable[int32]                `json:"default_auto_archive_duration,omitempty"`
+	Flags                         Optional[int32]                `json:"flags,omitempty"`
+	AvailableTags                 Optional[[]ForumTagParams]     `json:"available_tags,omitempty"`
+	DefaultReactionEmoji          Nullable[DefaultReactionEmoji] `json:"default_reaction_emoji,omitempty"`
+	DefaultThreadRateLimitPerUser Optional[int32]                `json:"default_thread_rate_limit_per_user,omitempty"`
+	DefaultSortOrder              Nullable[ForumSortOrder]       `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            Optional[ForumLayout]          `json:"default_forum_layout,omitempty"`
+
+	// Thread only fields.
+	Archived            Optional[bool]        `json:"archived,omitempty"`
+	AutoArchiveDuration Optional[int32]       `json:"auto_archive_duration,omitempty"`
+	Locked              Optional[bool]        `json:"locked,omitempty"`
+	Invitable           Optional[bool]        `json:"invitable,omitempty"`
+	AppliedTags         Optional[[]Snowflake] `json:"applied_tags,omitempty"`
+}
+
+// Validate checks the available tags of a forum or media channel and the applied tags of a
+// forum post are within Discord's limits.
+func (mp ModifyChannelParams) Validate() error {
+	availableTags, _ := mp.AvailableTags.Get()
+
+	err := validateAvailableTags(availableTags)
+	if err != nil {
+		return err
+	}
+
+	appliedTags, _ := mp.AppliedTags.Get()
+
+	return validateAppliedTags(appliedTags)
 }
 
 // CreateInvite creates an invite to a channel.
@@ -131,7 +192,7 @@ func (c *Channel) DeleteMessages(ctx context.Context, session *Session, messageI
 // Edit edits a channel.
 // channelArg: Parameters passed for editing a channel.
 // reason: Reason for editing the channel.
-func (c *Channel) Edit(ctx context.Context, session *Session, channelParams ChannelParams, reason *string) error {
+func (c *Channel) Edit(ctx context.Context, session *Session, channelParams ModifyChannelParams, reason *string) error {
 	newChannel, err := ModifyChannel(ctx, session, c.ID, channelParams, reason)
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var emojiEscaper = strings.NewReplacer("#", "%23")
@@ -24,7 +25,12 @@ func GetChannel(ctx context.Context, session *Session, channelID Snowflake) (*Ch
 	return channel, nil
 }
 
-func ModifyChannel(ctx context.Context, session *Session, channelID Snowflake, channelParams ChannelParams, reason *string) (*Channel, error) {
+func ModifyChannel(ctx context.Context, session *Session, channelID Snowflake, channelParams ModifyChannelParams, reason *string) (*Channel, error) {
+	err := channelParams.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	endpoint := EndpointChannel(channelID.String())
 
 	headers := http.Header{}
@@ -35,7 +41,7 @@ func ModifyChannel(ctx context.Context, session *Session, channelID Snowflake, c
 
 	var channel *Channel
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, channelParams, headers, &channel)
+	err = session.Interface.FetchJJ(ctx, session, http.MethodPatch, endpoint, channelParams, headers, &channel)
 	if err != nil {
 		return nil, fmt.Errorf("failed to modify channel: %w", err)
 	}
@@ -434,7 +440,7 @@ func UnpinMessage(ctx context.Context, session *Session, channelID, messageID Sn
 
 // CreateThreadParams represents parameters for creating a thread.
 type CreateThreadParams struct {
-	Name                int32        `json:"name"`
+	Name                string       `json:"name"`
 	AutoArchiveDuration int32        `json:"auto_archive_duration,omitempty"`
 	Type                *ChannelType `json:"type,omitempty"`
 	Invitable           *bool        `json:"invitable,omitempty"`
@@ -486,7 +492,7 @@ func StartThreadWithoutMessage(ctx context.Context, session *Session, channelID
 
 	var thread *Channel
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, params, nil, &thread)
+	err := session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, params, headers, &thread)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start thread without message: %w", err)
 	}
@@ -494,6 +500,74 @@ func StartThreadWithoutMessage(ctx context.Context, session *Session, channelID
 	return thread, nil
 }
 
+// CreateForumPost creates a post in a forum or media channel with its first message.
+// Returns ErrTooManyTags if more than MaxForumAppliedTags tags are applied.
+func CreateForumPost(ctx context.Context, session *Session, channelID Snowflake, params CreateForumPostParams, reason *string) (*ForumPost, error) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := EndpointChannelThreads(channelID.String())
+
+	headers := http.Header{}
+
+	if reason != nil {
+		headers.Add(AuditLogReasonHeader, *reason)
+	}
+
+	var post *ForumPost
+
+	if len(params.Message.Files) > 0 {
+		contentType, body, err := multipartBodyWithJSON(params, params.Message.Files)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create multipart body: %w", err)
+		}
+
+		err = session.Interface.FetchBJ(ctx, session, http.MethodPost, endpoint, contentType, body, headers, &post)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create forum post: %w", err)
+		}
+	} else {
+		err = session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, params, headers, &post)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create forum post: %w", err)
+		}
+	}
+
+	return post, nil
+}
+
+// SetForumPostTags replaces the tags applied to a forum post.
+// Returns ErrTooManyTags if more than MaxForumAppliedTags tags are applied.
+func SetForumPostTags(ctx context.Context, session *Session, threadID Snowflake, tagIDs []Snowflake, reason *string) (*Channel, error) {
+	return ModifyChannel(ctx, session, threadID, ModifyChannelParams{AppliedTags: NewOptional(tagIDs)}, reason)
+}
+
+// AddForumPostTags applies tags to a forum post, keeping the tags it already has. The post's tags
+// are fetched and then replaced, so this is not atomic and concurrent changes to the post's tags
+// may be lost. Use SetForumPostTags when the current tags are already known.
+func AddForumPostTags(ctx context.Context, session *Session, threadID Snowflake, tagIDs []Snowflake, reason *string) (*Channel, error) {
+	thread, err := GetChannel(ctx, session, threadID)
+	if err != nil {
+		return nil, err
+	}
+
+	return SetForumPostTags(ctx, session, threadID, addTags(thread.AppliedTags, tagIDs), reason)
+}
+
+// RemoveForumPostTags removes tags from a forum post, keeping any other tags it has. The post's
+// tags are fetched and then replaced, so this is not atomic and concurrent changes to the post's
+// tags may be lost. Use SetForumPostTags when the current tags are already known.
+func RemoveForumPostTags(ctx context.Context, session *Session, threadID Snowflake, tagIDs []Snowflake, reason *string) (*Channel, error) {
+	thread, err := GetChannel(ctx, session, threadID)
+	if err != nil {
+		return nil, err
+	}
+
+	return SetForumPostTags(ctx, session, threadID, removeTags(thread.AppliedTags, tagIDs), reason)
+}
+
 // JoinThread adds the current user to a thread.
 func JoinThread(ctx context.Context, session *Session, channelID Snowflake) error {
 	endpoint := EndpointChannel(channelID.String()) + "/thread-members/@me"
@@ -584,14 +658,15 @@ func ListThreadMembers(ctx context.Context, session *Session, channelID Snowflak
 	return threadMembers, nil
 }
 
-// ListPublicArchivedThreads lists archived public threads in a channel.
-func ListPublicArchivedThreads(ctx context.Context, session *Session, channelID Snowflake, before *string, limit *int32) (any, error) {
+// ListPublicArchivedThreads lists archived public threads in a channel, newest first.
+// before: Only return threads archived before this time.
+func ListPublicArchivedThreads(ctx context.Context, session *Session, channelID Snowflake, before *time.Time, limit *int32) (*ThreadsResponse, error) {
 	endpoint := EndpointChannel(channelID.String()) + "/threads/archived/public"
 
 	values := url.Values{}
 
 	if before != nil {
-		values.Add("before", *before)
+		values.Add("before", before.Format(time.RFC3339Nano))
 	}
 
 	if limit != nil {
@@ -602,7 +677,7 @@ func ListPublicArchivedThreads(ctx context.Context, session *Session, channelID
 		endpoint += "?" + values.Encode()
 	}
 
-	var threads any
+	var threads *ThreadsResponse
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &threads)
 	if err != nil {
@@ -612,14 +687,15 @@ func ListPublicArchivedThreads(ctx context.Context, session *Session, channelID
 	return threads, nil
 }
 
-// ListPrivateArchivedThreads lists archived private threads in a channel.
-func ListPrivateArchivedThreads(ctx context.Context, session *Session, channelID Snowflake, before *string, limit *int32) (any, error) {
+// ListPrivateArchivedThreads lists archived private threads in a channel, newest first.
+// before: Only return threads archived before this time.
+func ListPrivateArchivedThreads(ctx context.Context, session *Session, channelID Snowflake, before *time.Time, limit *int32) (*ThreadsResponse, error) {
 	endpoint := EndpointChannel(channelID.String()) + "/threads/archived/private"
 
 	values := url.Values{}
 
 	if before != nil {
-		values.Add("before", *before)
+		values.Add("before", before.Format(time.RFC3339Nano))
 	}
 
 	if limit != nil {
@@ -630,7 +706,7 @@ func ListPrivateArchivedThreads(ctx context.Context, session *Session, channelID
 		endpoint += "?" + values.Encode()
 	}
 
-	var threads any
+	var threads *ThreadsResponse
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &threads)
 	if err != nil {
@@ -640,14 +716,15 @@ func ListPrivateArchivedThreads(ctx context.Context, session *Session, channelID
 	return threads, nil
 }
 
-// ListJoinedPrivateArchivedThreads lists archived private threads the current user is a member of.
-func ListJoinedPrivateArchivedThreads(ctx context.Context, session *Session, channelID Snowflake, before *string, limit *int32) (any, error) {
+// ListJoinedPrivateArchivedThreads lists archived private threads the current user is a member of, newest first.
+// before: Only return threads with an ID before this snowflake.
+func ListJoinedPrivateArchivedThreads(ctx context.Context, session *Session, channelID Snowflake, before *Snowflake, limit *int32) (*ThreadsResponse, error) {
 	endpoint := EndpointChannel(channelID.String()) + "/users/@me/threads/archived/private"
 
 	values := url.Values{}
 
 	if before != nil {
-		values.Add("before", *before)
+		values.Add("before", before.String())
 	}
 
 	if limit != nil {
@@ -658,7 +735,7 @@ func ListJoinedPrivateArchivedThreads(ctx context.Context, session *Session, cha
 		endpoint += "?" + values.Encode()
 	}
 
-	var threads any
+	var threads *ThreadsResponse
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &threads)
 	if err != nil {
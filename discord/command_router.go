@@ -0,0 +1,576 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// command_router.go contains a router for application commands that dispatches by command
+// path and binds command options to structs.
+
+// CommandHandler handles an application command. The returned response may be nil if the
+// handler has already responded to the interaction itself.
+type CommandHandler func(ctx context.Context, interaction *Interaction, args CommandArgs) (*InteractionResponse, error)
+
+// CommandRouter routes application command interactions to handlers registered by command path.
+// Paths are the command name followed by any subcommand group and subcommand, such as
+// "/mod ban" or "/settings welcome channel".
+type CommandRouter struct {
+	handlers     map[string]CommandHandler
+	autocomplete map[string]AutocompleteHandler
+
+	// NotFound is called when no handler is registered for a command path. Optional.
+	NotFound CommandHandler
+
+	mu sync.RWMutex
+}
+
+// NewCommandRouter creates an empty command router.
+func NewCommandRouter() *CommandRouter {
+	return &CommandRouter{
+		handlers:     make(map[string]CommandHandler),
+		autocomplete: make(map[string]AutocompleteHandler),
+	}
+}
+
+// Register registers a handler for a command path.
+// path: The command path, for example "/mod ban".
+// handler: The handler that is called when the command is invoked.
+func (cr *CommandRouter) Register(path string, handler CommandHandler) *CommandRouter {
+	cr.mu.Lock()
+	cr.handlers[normalizeCommandPath(path)] = handler
+	cr.mu.Unlock()
+
+	return cr
+}
+
+// Handler returns the handler registered for a command path.
+func (cr *CommandRouter) Handler(path string) (CommandHandler, bool) {
+	cr.mu.RLock()
+	handler, ok := cr.handlers[normalizeCommandPath(path)]
+	cr.mu.RUnlock()
+
+	return handler, ok
+}
+
+// HandleInteraction dispatches an application command or autocomplete interaction to its handler.
+// This matches InteractionHandler, so a router can be passed to NewInteractionServer directly.
+func (cr *CommandRouter) HandleInteraction(ctx context.Context, interaction *Interaction) (*InteractionResponse, error) {
+	if interaction.Data == nil {
+		return nil, ErrUnknownCommand
+	}
+
+	switch interaction.Type {
+	case InteractionTypeApplicationCommand:
+	case InteractionTypeApplicationCommandAutocomplete:
+		return cr.handleAutocomplete(ctx, interaction)
+	default:
+		return nil, ErrUnknownCommand
+	}
+
+	path, options := interaction.Data.CommandPath()
+
+	handler, ok := cr.Handler(path)
+	if !ok {
+		if cr.NotFound == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCommand, path)
+		}
+
+		handler = cr.NotFound
+	}
+
+	return handler(ctx, interaction, CommandArgs{
+		Options:  options,
+		Resolved: interaction.Data.Resolved,
+	})
+}
+
+// CommandPath returns the invoked command path, including subcommand groups and subcommands,
+// along with the options passed to the innermost subcommand.
+func (id *InteractionData) CommandPath() (string, []InteractionDataOption) {
+	path := []string{id.Name}
+	options := id.Options
+
+	for len(options) > 0 {
+		option := options[0]
+
+		if option.Type != ApplicationCommandOptionTypeSubCommandGroup && option.Type != ApplicationCommandOptionTypeSubCommand {
+			break
+		}
+
+		path = append(path, option.Name)
+		options = option.Options
+	}
+
+	return strings.Join(path, " "), options
+}
+
+func normalizeCommandPath(path string) string {
+	return strings.Join(strings.Fields(strings.TrimPrefix(strings.TrimSpace(path), "/")), " ")
+}
+
+// CommandArgs represents the options passed to a command after the command path has been resolved.
+type CommandArgs struct {
+	Resolved *InteractionResolvedData
+	Options  []InteractionDataOption
+}
+
+// Mentionable represents a resolved mentionable option, which is either a user or a role.
+type Mentionable struct {
+	User   *User
+	Member *GuildMember
+	Role   *Role
+}
+
+// Option returns the option with the given name.
+func (ca CommandArgs) Option(name string) (*InteractionDataOption, bool) {
+	for i := range ca.Options {
+		if ca.Options[i].Name == name {
+			return &ca.Options[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// Has returns if an option with the given name was passed.
+func (ca CommandArgs) Has(name string) bool {
+	_, ok := ca.Option(name)
+
+	return ok
+}
+
+func (ca CommandArgs) unmarshal(name string, value any) bool {
+	option, ok := ca.Option(name)
+	if !ok || len(option.Value) == 0 {
+		return false
+	}
+
+	return json.Unmarshal(option.Value, value) == nil
+}
+
+// String returns the value of a string option.
+func (ca CommandArgs) String(name string) (string, bool) {
+	var value string
+
+	ok := ca.unmarshal(name, &value)
+
+	return value, ok
+}
+
+// Int returns the value of an integer option.
+func (ca CommandArgs) Int(name string) (int64, bool) {
+	var value int64
+
+	ok := ca.unmarshal(name, &value)
+
+	return value, ok
+}
+
+// Float returns the value of a number option.
+func (ca CommandArgs) Float(name string) (float64, bool) {
+	var value float64
+
+	ok := ca.unmarshal(name, &value)
+
+	return value, ok
+}
+
+// Bool returns the value of a boolean option.
+func (ca CommandArgs) Bool(name string) (bool, bool) {
+	var value bool
+
+	ok := ca.unmarshal(name, &value)
+
+	return value, ok
+}
+
+// Snowflake returns the ID passed to a user, channel, role, mentionable or attachment option.
+func (ca CommandArgs) Snowflake(name string) (Snowflake, bool) {
+	var value Snowflake
+
+	ok := ca.unmarshal(name, &value)
+
+	return value, ok
+}
+
+// User returns the resolved user of a user or mentionable option.
+func (ca CommandArgs) User(name string) (*User, bool) {
+	id, ok := ca.Snowflake(name)
+	if !ok || ca.Resolved == nil {
+		return nil, false
+	}
+
+	user, ok := ca.Resolved.Users[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &user, true
+}
+
+// Member returns the resolved guild member of a user or mentionable option.
+// The member's User will be populated from the resolved users.
+func (ca CommandArgs) Member(name string) (*GuildMember, bool) {
+	id, ok := ca.Snowflake(name)
+	if !ok || ca.Resolved == nil {
+		return nil, false
+	}
+
+	member, ok := ca.Resolved.Members[id]
+	if !ok {
+		return nil, false
+	}
+
+	if member.User == nil {
+		if user, ok := ca.Resolved.Users[id]; ok {
+			member.User = &user
+		}
+	}
+
+	return &member, true
+}
+
+// Role returns the resolved role of a role or mentionable option.
+func (ca CommandArgs) Role(name string) (*Role, bool) {
+	id, ok := ca.Snowflake(name)
+	if !ok || ca.Resolved == nil {
+		return nil, false
+	}
+
+	role, ok := ca.Resolved.Roles[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &role, true
+}
+
+// Channel returns the resolved partial channel of a channel option.
+func (ca CommandArgs) Channel(name string) (*Channel, bool) {
+	id, ok := ca.Snowflake(name)
+	if !ok || ca.Resolved == nil {
+		return nil, false
+	}
+
+	channel, ok := ca.Resolved.Channels[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &channel, true
+}
+
+// Attachment returns the resolved attachment of an attachment option.
+func (ca CommandArgs) Attachment(name string) (*MessageAttachment, bool) {
+	id, ok := ca.Snowflake(name)
+	if !ok || ca.Resolved == nil {
+		return nil, false
+	}
+
+	attachment, ok := ca.Resolved.Attachments[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &attachment, true
+}
+
+// Mentionable returns the resolved user or role of a mentionable option.
+func (ca CommandArgs) Mentionable(name string) (*Mentionable, bool) {
+	mentionable := &Mentionable{}
+
+	mentionable.User, _ = ca.User(name)
+	mentionable.Member, _ = ca.Member(name)
+	mentionable.Role, _ = ca.Role(name)
+
+	if mentionable.User == nil && mentionable.Role == nil {
+		return nil, false
+	}
+
+	return mentionable, true
+}
+
+var (
+	snowflakeType         = reflect.TypeOf(Snowflake(0))
+	userType              = reflect.TypeOf(User{})
+	guildMemberType       = reflect.TypeOf(GuildMember{})
+	roleType              = reflect.TypeOf(Role{})
+	channelType           = reflect.TypeOf(Channel{})
+	messageAttachmentType = reflect.TypeOf(MessageAttachment{})
+	mentionableType       = reflect.TypeOf(Mentionable{})
+)
+
+// Bind populates the fields of the struct pointed to by dst from the command options.
+// Fields are matched by the name in their discord struct tag, such as `discord:"name=user"`,
+// or the lowercase field name when no tag is set. Fields tagged `discord:"-"` are skipped.
+//
+// Supported field types are strings, integers, floats, booleans, Snowflake, User, GuildMember,
+// Role, Channel, MessageAttachment and Mentionable. User, member, role, channel, attachment and
+// mentionable fields are resolved from the interaction's resolved data. Pointer fields are left
+// nil when an option is not passed.
+func (ca CommandArgs) Bind(dst any) error {
+	value, err := structPointer(dst)
+	if err != nil {
+		return err
+	}
+
+	return ca.bindStruct(value, false)
+}
+
+func structPointer(dst any) (reflect.Value, error) {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%w: expected pointer to struct, got %T", ErrInvalidBindTarget, dst)
+	}
+
+	return value.Elem(), nil
+}
+
+// bindStruct binds options to the fields of a struct. Struct fields that are not a resolvable
+// type are treated as subcommands or subcommand groups. When strict is set, missing required
+// options will return an error.
+func (ca CommandArgs) bindStruct(value reflect.Value, strict bool) error {
+	valueType := value.Type()
+
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, ok, err := parseCommandTag(field)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		option, ok := ca.Option(tag.Name)
+
+		if isSubCommandType(field.Type) {
+			if !ok || (option.Type != ApplicationCommandOptionTypeSubCommand && option.Type != ApplicationCommandOptionTypeSubCommandGroup) {
+				continue
+			}
+
+			err = ca.bindSubCommand(option, value.Field(i), strict)
+			if err != nil {
+				return fmt.Errorf("failed to bind subcommand %s: %w", tag.Name, err)
+			}
+
+			continue
+		}
+
+		if !ok {
+			if strict && tag.Has("required") {
+				return fmt.Errorf("%w: %s", ErrMissingOption, tag.Name)
+			}
+
+			continue
+		}
+
+		err = ca.bindField(tag.Name, value.Field(i))
+		if err != nil {
+			return fmt.Errorf("failed to bind option %s: %w", tag.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (ca CommandArgs) bindSubCommand(option *InteractionDataOption, field reflect.Value, strict bool) error {
+	subCommandArgs := CommandArgs{
+		Options:  option.Options,
+		Resolved: ca.Resolved,
+	}
+
+	if field.Kind() == reflect.Pointer {
+		target := reflect.New(field.Type().Elem())
+
+		err := subCommandArgs.bindStruct(target.Elem(), strict)
+		if err != nil {
+			return err
+		}
+
+		field.Set(target)
+
+		return nil
+	}
+
+	return subCommandArgs.bindStruct(field, strict)
+}
+
+func (ca CommandArgs) bindField(name string, field reflect.Value) error {
+	if field.Kind() == reflect.Pointer {
+		target := reflect.New(field.Type().Elem())
+
+		err := ca.bindField(name, target.Elem())
+		if err != nil {
+			return err
+		}
+
+		field.Set(target)
+
+		return nil
+	}
+
+	var resolved any
+
+	var ok bool
+
+	switch field.Type() {
+	case snowflakeType:
+		resolved, ok = ca.Snowflake(name)
+	case userType:
+		resolved, ok = ca.User(name)
+	case guildMemberType:
+		resolved, ok = ca.Member(name)
+	case roleType:
+		resolved, ok = ca.Role(name)
+	case channelType:
+		resolved, ok = ca.Channel(name)
+	case messageAttachmentType:
+		resolved, ok = ca.Attachment(name)
+	case mentionableType:
+		resolved, ok = ca.Mentionable(name)
+	default:
+		option, _ := ca.Option(name)
+
+		target := reflect.New(field.Type())
+
+		err := json.Unmarshal(option.Value, target.Interface())
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal value: %w", err)
+		}
+
+		field.Set(target.Elem())
+
+		return nil
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnresolvedOption, name)
+	}
+
+	resolvedValue := reflect.ValueOf(resolved)
+	if resolvedValue.Kind() == reflect.Pointer {
+		resolvedValue = resolvedValue.Elem()
+	}
+
+	field.Set(resolvedValue)
+
+	return nil
+}
+
+// commandTag represents the parsed discord struct tag of a field.
+type commandTag struct {
+	Values map[string]string
+	Name   string
+}
+
+// Has returns if a key is present in the tag.
+func (ct commandTag) Has(key string) bool {
+	_, ok := ct.Values[key]
+
+	return ok
+}
+
+// commandTagKeys are the keys supported in a discord struct tag.
+var commandTagKeys = map[string]bool{
+	"name":          true,
+	"description":   true,
+	"required":      true,
+	"min":           true,
+	"max":           true,
+	"min_length":    true,
+	"max_length":    true,
+	"choices":       true,
+	"channel_types": true,
+	"autocomplete":  true,
+	"type":          true,
+}
+
+// parseCommandTag parses a discord struct tag in the form `discord:"name=user,required"`.
+// Values containing commas can be wrapped in single quotes, such as
+// `discord:"description='Pick a user, or role'"`, and a backslash escapes the next character.
+// Returns false if the field should be skipped, and ErrInvalidCommandStruct for unknown keys.
+func parseCommandTag(field reflect.StructField) (commandTag, bool, error) {
+	raw, hasTag := field.Tag.Lookup("discord")
+	if raw == "-" {
+		return commandTag{}, false, nil
+	}
+
+	tag := commandTag{
+		Values: make(map[string]string),
+	}
+
+	if hasTag {
+		parts, err := splitCommandTag(raw)
+		if err != nil {
+			return tag, false, fmt.Errorf("%w: field %s: %w", ErrInvalidCommandStruct, field.Name, err)
+		}
+
+		for _, part := range parts {
+			key, value, _ := strings.Cut(part, "=")
+			key = strings.TrimSpace(key)
+
+			if key == "" {
+				continue
+			}
+
+			if !commandTagKeys[key] {
+				return tag, false, fmt.Errorf("%w: field %s: unknown tag key %q", ErrInvalidCommandStruct, field.Name, key)
+			}
+
+			tag.Values[key] = strings.TrimSpace(value)
+		}
+	}
+
+	tag.Name = tag.Values["name"]
+	if tag.Name == "" {
+		tag.Name = strings.ToLower(field.Name)
+	}
+
+	return tag, true, nil
+}
+
+// splitCommandTag splits a discord struct tag on commas that are not quoted or escaped, removing
+// the quotes and escapes.
+func splitCommandTag(raw string) ([]string, error) {
+	var (
+		parts   []string
+		current strings.Builder
+		quoted  bool
+		escaped bool
+	)
+
+	for _, r := range raw {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '\'':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if quoted || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", raw)
+	}
+
+	return append(parts, current.String()), nil
+}
@@ -0,0 +1,442 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// command_struct.go derives application command definitions from go structs, so the same
+// struct is used for registering a command and decoding its options.
+//
+// Fields are configured with the discord struct tag, for example:
+//
+//	type BanArgs struct {
+//		Target  GuildMember `discord:"name=user,description=User to ban,required"`
+//		Days    *int        `discord:"description=Days of messages to delete,min=0,max=7"`
+//		Reason  *string     `discord:"description=Reason for the ban,max=512,autocomplete"`
+//		Channel *Channel    `discord:"description=Log channel,channel_types=text|announcement"`
+//		Mode    string      `discord:"description=Mode,choices=Soft:soft|Hard:hard"`
+//	}
+//
+// Supported keys are name, description, required, min, max, min_length, max_length, choices,
+// channel_types, autocomplete and type. Values containing commas can be wrapped in single quotes,
+// such as description='Pick a user, or role'. Unknown keys return ErrInvalidCommandStruct. Struct fields that are not a resolvable type are
+// treated as subcommands, and as subcommand groups if they themselves contain subcommands.
+
+var channelTypeNames = map[string]ChannelType{
+	"text":                ChannelTypeGuildText,
+	"dm":                  ChannelTypeDM,
+	"voice":               ChannelTypeGuildVoice,
+	"group_dm":            ChannelTypeGroupDM,
+	"category":            ChannelTypeGuildCategory,
+	"announcement":        ChannelTypeGuildAnnouncement,
+	"announcement_thread": ChannelTypeAnnouncementThread,
+	"public_thread":       ChannelTypeGuildPublicThread,
+	"private_thread":      ChannelTypeGuildPrivateThread,
+	"stage":               ChannelTypeGuildStageVoice,
+	"directory":           ChannelTypeGuildDirectory,
+	"forum":               ChannelTypeGuildForum,
+	"media":               ChannelTypeGuildMedia,
+}
+
+var optionTypeNames = map[string]ApplicationCommandOptionType{
+	"string":      ApplicationCommandOptionTypeString,
+	"integer":     ApplicationCommandOptionTypeInteger,
+	"boolean":     ApplicationCommandOptionTypeBoolean,
+	"user":        ApplicationCommandOptionTypeUser,
+	"channel":     ApplicationCommandOptionTypeChannel,
+	"role":        ApplicationCommandOptionTypeRole,
+	"mentionable": ApplicationCommandOptionTypeMentionable,
+	"number":      ApplicationCommandOptionTypeNumber,
+	"attachment":  ApplicationCommandOptionTypeAttachment,
+}
+
+// NewApplicationCommandFromStruct creates a chat input command with options derived from a struct.
+// name: The name of the command.
+// description: The description of the command.
+// args: A struct, or pointer to a struct, describing the command options.
+func NewApplicationCommandFromStruct(name, description string, args any) (*ApplicationCommand, error) {
+	options, err := CommandOptionsFromStruct(args)
+	if err != nil {
+		return nil, err
+	}
+
+	commandType := ApplicationCommandTypeChatInput
+
+	return &ApplicationCommand{
+		Type:        &commandType,
+		Name:        name,
+		Description: description,
+		Options:     options,
+	}, nil
+}
+
+// CommandOptionsFromStruct returns the command options described by a struct.
+func CommandOptionsFromStruct(args any) ([]ApplicationCommandOption, error) {
+	argsType := reflect.TypeOf(args)
+	if argsType != nil && argsType.Kind() == reflect.Pointer {
+		argsType = argsType.Elem()
+	}
+
+	if argsType == nil || argsType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: expected struct, got %T", ErrInvalidCommandStruct, args)
+	}
+
+	return commandOptionsFromType(argsType)
+}
+
+func commandOptionsFromType(argsType reflect.Type) ([]ApplicationCommandOption, error) {
+	options := make([]ApplicationCommandOption, 0, argsType.NumField())
+
+	for i := 0; i < argsType.NumField(); i++ {
+		field := argsType.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, ok, err := parseCommandTag(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		option, err := commandOptionFromField(field, tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		options = append(options, option)
+	}
+
+	// Discord requires required options to be listed before optional ones.
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].Required && !options[j].Required
+	})
+
+	return options, nil
+}
+
+func commandOptionFromField(field reflect.StructField, tag commandTag) (ApplicationCommandOption, error) {
+	option := ApplicationCommandOption{
+		Name:        tag.Name,
+		Description: tag.Values["description"],
+	}
+
+	if option.Description == "" {
+		return option, fmt.Errorf("%w: missing description", ErrInvalidCommandStruct)
+	}
+
+	if isSubCommandType(field.Type) {
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		options, err := commandOptionsFromType(fieldType)
+		if err != nil {
+			return option, err
+		}
+
+		option.Type = ApplicationCommandOptionTypeSubCommand
+
+		for _, subOption := range options {
+			if subOption.Type == ApplicationCommandOptionTypeSubCommand {
+				option.Type = ApplicationCommandOptionTypeSubCommandGroup
+
+				break
+			}
+		}
+
+		option.Options = options
+
+		return option, nil
+	}
+
+	optionType, err := commandOptionType(field.Type, tag)
+	if err != nil {
+		return option, err
+	}
+
+	option.Type = optionType
+	option.Required = tag.Has("required")
+
+	if tag.Has("autocomplete") {
+		autocomplete := true
+		option.Autocomplete = &autocomplete
+	}
+
+	if optionType == ApplicationCommandOptionTypeString {
+		option.MinLength, err = parseCommandTagInt(tag, "min_length", "min")
+		if err != nil {
+			return option, err
+		}
+
+		option.MaxLength, err = parseCommandTagInt(tag, "max_length", "max")
+		if err != nil {
+			return option, err
+		}
+	} else {
+		option.MinValue, err = parseCommandTagNumber(tag, "min", optionType)
+		if err != nil {
+			return option, err
+		}
+
+		option.MaxValue, err = parseCommandTagNumber(tag, "max", optionType)
+		if err != nil {
+			return option, err
+		}
+	}
+
+	if choices, ok := tag.Values["choices"]; ok {
+		option.Choices, err = parseCommandChoices(choices, optionType)
+		if err != nil {
+			return option, err
+		}
+	}
+
+	if channelTypes, ok := tag.Values["channel_types"]; ok {
+		if optionType != ApplicationCommandOptionTypeChannel {
+			return option, fmt.Errorf("%w: channel_types set on non-channel option", ErrInvalidCommandStruct)
+		}
+
+		option.ChannelTypes, err = parseCommandChannelTypes(channelTypes)
+		if err != nil {
+			return option, err
+		}
+	}
+
+	return option, nil
+}
+
+// isSubCommandType returns true if a field type represents a subcommand or subcommand group.
+func isSubCommandType(fieldType reflect.Type) bool {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	if fieldType.Kind() != reflect.Struct {
+		return false
+	}
+
+	switch fieldType {
+	case userType, guildMemberType, roleType, channelType, messageAttachmentType, mentionableType:
+		return false
+	default:
+		return true
+	}
+}
+
+func commandOptionType(fieldType reflect.Type, tag commandTag) (ApplicationCommandOptionType, error) {
+	if typeName, ok := tag.Values["type"]; ok {
+		optionType, ok := optionTypeNames[typeName]
+		if !ok {
+			return 0, fmt.Errorf("%w: unknown option type %s", ErrInvalidCommandStruct, typeName)
+		}
+
+		return optionType, nil
+	}
+
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	switch fieldType {
+	case snowflakeType:
+		return 0, fmt.Errorf("%w: Snowflake fields require a type", ErrInvalidCommandStruct)
+	case userType, guildMemberType:
+		return ApplicationCommandOptionTypeUser, nil
+	case roleType:
+		return ApplicationCommandOptionTypeRole, nil
+	case channelType:
+		return ApplicationCommandOptionTypeChannel, nil
+	case messageAttachmentType:
+		return ApplicationCommandOptionTypeAttachment, nil
+	case mentionableType:
+		return ApplicationCommandOptionTypeMentionable, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		return ApplicationCommandOptionTypeString, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ApplicationCommandOptionTypeInteger, nil
+	case reflect.Float32, reflect.Float64:
+		return ApplicationCommandOptionTypeNumber, nil
+	case reflect.Bool:
+		return ApplicationCommandOptionTypeBoolean, nil
+	default:
+		return 0, fmt.Errorf("%w: unsupported field type %s", ErrInvalidCommandStruct, fieldType)
+	}
+}
+
+// parseCommandTagInt returns the first of the keys present in the tag as an int32.
+func parseCommandTagInt(tag commandTag, keys ...string) (*int32, error) {
+	for _, key := range keys {
+		raw, ok := tag.Values[key]
+		if !ok {
+			continue
+		}
+
+		value, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid %s: %v", ErrInvalidCommandStruct, key, err)
+		}
+
+		result := int32(value)
+
+		return &result, nil
+	}
+
+	return nil, nil
+}
+
+// parseCommandTagNumber returns the value of a key in the tag as a bound for an option. Number
+// options accept decimals, and other options must be integers.
+func parseCommandTagNumber(tag commandTag, key string, optionType ApplicationCommandOptionType) (*float64, error) {
+	raw, ok := tag.Values[key]
+	if !ok {
+		return nil, nil
+	}
+
+	var result float64
+
+	if optionType == ApplicationCommandOptionTypeNumber {
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid %s: %v", ErrInvalidCommandStruct, key, err)
+		}
+
+		result = value
+	} else {
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid %s: %v", ErrInvalidCommandStruct, key, err)
+		}
+
+		result = float64(value)
+	}
+
+	return &result, nil
+}
+
+// parseCommandChoices parses choices in the form "name:value|name:value". If a choice
+// has no value, the name is used as the value.
+func parseCommandChoices(raw string, optionType ApplicationCommandOptionType) ([]ApplicationCommandOptionChoice, error) {
+	parts := strings.Split(raw, "|")
+	choices := make([]ApplicationCommandOptionChoice, 0, len(parts))
+
+	for _, part := range parts {
+		name, value, ok := strings.Cut(part, ":")
+		if !ok {
+			value = name
+		}
+
+		var choiceValue any
+
+		var err error
+
+		switch optionType {
+		case ApplicationCommandOptionTypeString:
+			choiceValue = value
+		case ApplicationCommandOptionTypeInteger:
+			choiceValue, err = strconv.ParseInt(value, 10, 64)
+		case ApplicationCommandOptionTypeNumber:
+			choiceValue, err = strconv.ParseFloat(value, 64)
+		default:
+			return nil, fmt.Errorf("%w: choices are not supported for option type %d", ErrInvalidCommandStruct, optionType)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid choice %s: %v", ErrInvalidCommandStruct, part, err)
+		}
+
+		marshaled, err := json.Marshal(choiceValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal choice: %w", err)
+		}
+
+		choices = append(choices, ApplicationCommandOptionChoice{
+			Name:  name,
+			Value: marshaled,
+		})
+	}
+
+	return choices, nil
+}
+
+// parseCommandChannelTypes parses channel types in the form "text|voice|5".
+func parseCommandChannelTypes(raw string) (ChannelTypeList, error) {
+	parts := strings.Split(raw, "|")
+	channelTypes := make(ChannelTypeList, 0, len(parts))
+
+	for _, part := range parts {
+		if channelType, ok := channelTypeNames[part]; ok {
+			channelTypes = append(channelTypes, channelType)
+
+			continue
+		}
+
+		value, err := strconv.ParseUint(part, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unknown channel type %s", ErrInvalidCommandStruct, part)
+		}
+
+		channelTypes = append(channelTypes, ChannelType(value))
+	}
+
+	return channelTypes, nil
+}
+
+// Decode populates the struct pointed to by dst from the command options, using the same
+// struct that was passed to CommandOptionsFromStruct. Unlike Bind, missing required options
+// return ErrMissingOption. Subcommand fields are populated only for the invoked subcommand.
+func (ca CommandArgs) Decode(dst any) error {
+	value, err := structPointer(dst)
+	if err != nil {
+		return err
+	}
+
+	return ca.bindStruct(value, true)
+}
+
+// Decode populates the struct pointed to by dst from all options of the interaction,
+// including subcommand groups and subcommands.
+func (id *InteractionData) Decode(dst any) error {
+	return CommandArgs{
+		Options:  id.Options,
+		Resolved: id.Resolved,
+	}.Decode(dst)
+}
+
+// NewCommandHandler returns a CommandHandler that decodes all options of the interaction into T
+// before calling handler. T is the same root struct passed to NewApplicationCommandFromStruct,
+// so subcommand fields are populated for the invoked subcommand.
+func NewCommandHandler[T any](handler func(ctx context.Context, interaction *Interaction, args *T) (*InteractionResponse, error)) CommandHandler {
+	return func(ctx context.Context, interaction *Interaction, commandArgs CommandArgs) (*InteractionResponse, error) {
+		args := new(T)
+
+		var err error
+
+		if interaction.Data != nil {
+			err = interaction.Data.Decode(args)
+		} else {
+			err = commandArgs.Decode(args)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode command options: %w", err)
+		}
+
+		return handler(ctx, interaction, args)
+	}
+}
@@ -0,0 +1,413 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// command_sync.go contains helpers for synchronising application commands with discord by
+// only sending the changes between the registered and desired commands.
+
+// CommandSyncActionType represents the type of change made to a command when syncing.
+type CommandSyncActionType string
+
+const (
+	CommandSyncActionCreate CommandSyncActionType = "create"
+	CommandSyncActionEdit   CommandSyncActionType = "edit"
+	CommandSyncActionDelete CommandSyncActionType = "delete"
+)
+
+// CommandSyncAction represents a single change made when syncing commands.
+type CommandSyncAction struct {
+	CommandID *Snowflake
+	Command   *ApplicationCommand
+	Type      CommandSyncActionType
+	Name      string
+}
+
+// CommandSyncPlan represents the changes required to sync the commands of a single scope.
+// GuildID is nil for global commands.
+type CommandSyncPlan struct {
+	GuildID   *Snowflake
+	Desired   []ApplicationCommand
+	Actions   []CommandSyncAction
+	Unchanged []string
+	Overwrite bool
+}
+
+// HasChanges returns if the plan requires any requests to be made.
+func (p *CommandSyncPlan) HasChanges() bool {
+	return p.Overwrite || len(p.Actions) > 0
+}
+
+func (p *CommandSyncPlan) String() string {
+	var builder strings.Builder
+
+	if p.GuildID == nil {
+		builder.WriteString("global commands")
+	} else {
+		builder.WriteString("guild " + p.GuildID.String() + " commands")
+	}
+
+	if !p.HasChanges() {
+		builder.WriteString(": up to date\n")
+
+		return builder.String()
+	}
+
+	if p.Overwrite {
+		builder.WriteString(": bulk overwrite\n")
+	} else {
+		builder.WriteString(":\n")
+	}
+
+	for _, action := range p.Actions {
+		builder.WriteString("  " + string(action.Type) + " " + action.Name)
+
+		if action.CommandID != nil {
+			builder.WriteString(" (" + action.CommandID.String() + ")")
+		}
+
+		builder.WriteString("\n")
+	}
+
+	for _, name := range p.Unchanged {
+		builder.WriteString("  unchanged " + name + "\n")
+	}
+
+	return builder.String()
+}
+
+// CommandSyncOptions represents the options used when syncing commands.
+type CommandSyncOptions struct {
+	// GuildCommands contains the desired commands for each guild. Guilds with an empty
+	// list will have all of their commands removed.
+	GuildCommands map[Snowflake][]ApplicationCommand
+
+	// GlobalCommands contains the desired global commands. If nil, global commands are not synced.
+	GlobalCommands []ApplicationCommand
+
+	// OverwriteThreshold is the number of individual changes at which a single bulk overwrite
+	// is used instead. A value of 0 never uses bulk overwrite.
+	OverwriteThreshold int
+
+	// DryRun computes the plans without making any changes.
+	DryRun bool
+}
+
+// SyncCommands compares the registered global and guild commands with the desired commands
+// and only creates, edits or deletes the commands that have changed. Fields assigned by
+// discord such as ID, Version and ApplicationID are ignored when comparing.
+func SyncCommands(ctx context.Context, session *Session, applicationID Snowflake, options CommandSyncOptions) ([]*CommandSyncPlan, error) {
+	plans := make([]*CommandSyncPlan, 0, len(options.GuildCommands)+1)
+
+	if options.GlobalCommands != nil {
+		existing, err := GetGlobalApplicationCommands(ctx, session, applicationID, true)
+		if err != nil {
+			return plans, err
+		}
+
+		plan := PlanCommandSync(nil, existing, options.GlobalCommands, options.OverwriteThreshold)
+		plans = append(plans, plan)
+
+		if !options.DryRun {
+			err = applyCommandSyncPlan(ctx, session, applicationID, plan)
+			if err != nil {
+				return plans, err
+			}
+		}
+	}
+
+	guildIDs := make([]Snowflake, 0, len(options.GuildCommands))
+	for guildID := range options.GuildCommands {
+		guildIDs = append(guildIDs, guildID)
+	}
+
+	sort.Slice(guildIDs, func(i, j int) bool {
+		return guildIDs[i] < guildIDs[j]
+	})
+
+	for _, guildID := range guildIDs {
+		existing, err := getGuildApplicationCommandsWithLocalizations(ctx, session, applicationID, guildID)
+		if err != nil {
+			return plans, err
+		}
+
+		plan := PlanCommandSync(&guildID, existing, options.GuildCommands[guildID], options.OverwriteThreshold)
+		plans = append(plans, plan)
+
+		if !options.DryRun {
+			err = applyCommandSyncPlan(ctx, session, applicationID, plan)
+			if err != nil {
+				return plans, err
+			}
+		}
+	}
+
+	return plans, nil
+}
+
+func getGuildApplicationCommandsWithLocalizations(ctx context.Context, session *Session, applicationID, guildID Snowflake) ([]ApplicationCommand, error) {
+	endpoint := EndpointApplicationGuildCommands(applicationID.String(), guildID.String()) + "?with_localizations=true"
+
+	var commands []ApplicationCommand
+
+	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &commands)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild application commands: %w", err)
+	}
+
+	return commands, nil
+}
+
+// PlanCommandSync computes the changes required to turn the existing commands into the desired commands.
+// guildID: The guild the commands belong to, nil for global commands.
+// overwriteThreshold: number of changes at which a bulk overwrite is planned instead, 0 to disable.
+func PlanCommandSync(guildID *Snowflake, existing, desired []ApplicationCommand, overwriteThreshold int) *CommandSyncPlan {
+	plan := &CommandSyncPlan{
+		GuildID: guildID,
+		Desired: desired,
+	}
+
+	existingByKey := make(map[string]ApplicationCommand, len(existing))
+	for _, command := range existing {
+		existingByKey[commandSyncKey(command)] = command
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+
+	for i := range desired {
+		command := desired[i]
+		key := commandSyncKey(command)
+		desiredKeys[key] = true
+
+		current, ok := existingByKey[key]
+		if !ok {
+			plan.Actions = append(plan.Actions, CommandSyncAction{
+				Type:    CommandSyncActionCreate,
+				Name:    command.Name,
+				Command: &command,
+			})
+
+			continue
+		}
+
+		if CommandsEqual(current, command) {
+			plan.Unchanged = append(plan.Unchanged, command.Name)
+
+			continue
+		}
+
+		plan.Actions = append(plan.Actions, CommandSyncAction{
+			Type:      CommandSyncActionEdit,
+			Name:      command.Name,
+			CommandID: current.ID,
+			Command:   &command,
+		})
+	}
+
+	for _, command := range existing {
+		if desiredKeys[commandSyncKey(command)] {
+			continue
+		}
+
+		plan.Actions = append(plan.Actions, CommandSyncAction{
+			Type:      CommandSyncActionDelete,
+			Name:      command.Name,
+			CommandID: command.ID,
+		})
+	}
+
+	plan.Overwrite = overwriteThreshold > 0 && len(plan.Actions) >= overwriteThreshold
+
+	return plan
+}
+
+func applyCommandSyncPlan(ctx context.Context, session *Session, applicationID Snowflake, plan *CommandSyncPlan) error {
+	if plan.Overwrite {
+		desired := plan.Desired
+		if desired == nil {
+			desired = make([]ApplicationCommand, 0)
+		}
+
+		var err error
+
+		if plan.GuildID == nil {
+			_, err = BulkOverwriteGlobalApplicationCommands(ctx, session, applicationID, desired)
+		} else {
+			_, err = BulkOverwriteGuildApplicationCommands(ctx, session, applicationID, *plan.GuildID, desired)
+		}
+
+		return err
+	}
+
+	for _, action := range plan.Actions {
+		var err error
+
+		switch {
+		case action.Type == CommandSyncActionCreate && plan.GuildID == nil:
+			_, err = CreateGlobalApplicationCommand(ctx, session, applicationID, *action.Command)
+		case action.Type == CommandSyncActionCreate:
+			_, err = CreateGuildApplicationCommand(ctx, session, applicationID, *plan.GuildID, *action.Command)
+		case action.Type == CommandSyncActionEdit && plan.GuildID == nil:
+			_, err = EditGlobalApplicationCommand(ctx, session, applicationID, *action.CommandID, *action.Command)
+		case action.Type == CommandSyncActionEdit:
+			_, err = EditGuildApplicationCommand(ctx, session, applicationID, *plan.GuildID, *action.CommandID, *action.Command)
+		case action.Type == CommandSyncActionDelete && plan.GuildID == nil:
+			err = DeleteGlobalApplicationCommand(ctx, session, applicationID, *action.CommandID)
+		case action.Type == CommandSyncActionDelete:
+			err = DeleteGuildApplicationCommand(ctx, session, applicationID, *plan.GuildID, *action.CommandID)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commandSyncKey returns the key commands are matched by. Command names are only unique per type.
+func commandSyncKey(command ApplicationCommand) string {
+	commandType := ApplicationCommandTypeChatInput
+	if command.Type != nil {
+		commandType = *command.Type
+	}
+
+	return fmt.Sprintf("%d:%s", commandType, command.Name)
+}
+
+// CommandsEqual returns if two commands are semantically equal. Fields assigned by discord
+// are ignored and unset fields are compared as their defaults.
+func CommandsEqual(a, b ApplicationCommand) bool {
+	aJSON, aErr := json.Marshal(normalizeCommand(a))
+	bJSON, bErr := json.Marshal(normalizeCommand(b))
+
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
+
+// normalizedCommand contains the fields of a command that are compared when syncing.
+type normalizedCommand struct {
+	DefaultMemberPermission  *Int64                 `json:"default_member_permissions"`
+	NameLocalizations        map[string]string      `json:"name_localizations"`
+	DescriptionLocalizations map[string]string      `json:"description_localizations"`
+	Name                     string                 `json:"name"`
+	Description              string                 `json:"description"`
+	Options                  []normalizedOption     `json:"options"`
+	Type                     ApplicationCommandType `json:"type"`
+	DMPermission             bool                   `json:"dm_permission"`
+}
+
+// normalizedOption contains the fields of a command option that are compared when syncing.
+type normalizedOption struct {
+	MinValue                 *float64                     `json:"min_value"`
+	MaxValue                 *float64                     `json:"max_value"`
+	MinLength                *int32                       `json:"min_length"`
+	MaxLength                *int32                       `json:"max_length"`
+	NameLocalizations        map[string]string            `json:"name_localizations"`
+	DescriptionLocalizations map[string]string            `json:"description_localizations"`
+	Name                     string                       `json:"name"`
+	Description              string                       `json:"description"`
+	ChannelTypes             []ChannelType                `json:"channel_types"`
+	Options                  []normalizedOption           `json:"options"`
+	Choices                  []normalizedChoice           `json:"choices"`
+	Type                     ApplicationCommandOptionType `json:"type"`
+	Required                 bool                         `json:"required"`
+	Autocomplete             bool                         `json:"autocomplete"`
+}
+
+// normalizedChoice contains the fields of an option choice that are compared when syncing.
+type normalizedChoice struct {
+	NameLocalizations map[string]string `json:"name_localizations"`
+	Name              string            `json:"name"`
+	Value             string            `json:"value"`
+}
+
+func normalizeCommand(command ApplicationCommand) normalizedCommand {
+	normalized := normalizedCommand{
+		DefaultMemberPermission:  command.DefaultMemberPermission,
+		NameLocalizations:        normalizeLocalizations(command.NameLocalizations),
+		DescriptionLocalizations: normalizeLocalizations(command.DescriptionLocalizations),
+		Name:                     command.Name,
+		Description:              command.Description,
+		Options:                  normalizeOptions(command.Options),
+		Type:                     ApplicationCommandTypeChatInput,
+		DMPermission:             true,
+	}
+
+	if command.Type != nil {
+		normalized.Type = *command.Type
+	}
+
+	if command.DMPermission != nil {
+		normalized.DMPermission = *command.DMPermission
+	}
+
+	return normalized
+}
+
+func normalizeOptions(options []ApplicationCommandOption) []normalizedOption {
+	if len(options) == 0 {
+		return nil
+	}
+
+	normalized := make([]normalizedOption, len(options))
+
+	for i, option := range options {
+		normalized[i] = normalizedOption{
+			MinValue:                 option.MinValue,
+			MaxValue:                 option.MaxValue,
+			MinLength:                option.MinLength,
+			MaxLength:                option.MaxLength,
+			NameLocalizations:        normalizeLocalizations(option.NameLocalizations),
+			DescriptionLocalizations: normalizeLocalizations(option.DescriptionLocalizations),
+			Name:                     option.Name,
+			Description:              option.Description,
+			Options:                  normalizeOptions(option.Options),
+			Type:                     option.Type,
+			Required:                 option.Required,
+			Autocomplete:             option.Autocomplete != nil && *option.Autocomplete,
+		}
+
+		if len(option.ChannelTypes) > 0 {
+			channelTypes := make([]ChannelType, len(option.ChannelTypes))
+			copy(channelTypes, option.ChannelTypes)
+
+			sort.Slice(channelTypes, func(i, j int) bool {
+				return channelTypes[i] < channelTypes[j]
+			})
+
+			normalized[i].ChannelTypes = channelTypes
+		}
+
+		for _, choice := range option.Choices {
+			var value bytes.Buffer
+
+			if json.Compact(&value, choice.Value) != nil {
+				value.Reset()
+				value.Write(choice.Value)
+			}
+
+			normalized[i].Choices = append(normalized[i].Choices, normalizedChoice{
+				NameLocalizations: normalizeLocalizations(choice.NameLocalizations),
+				Name:              choice.Name,
+				Value:             value.String(),
+			})
+		}
+	}
+
+	return normalized
+}
+
+func normalizeLocalizations(localizations map[string]string) map[string]string {
+	if len(localizations) == 0 {
+		return nil
+	}
+
+	return localizations
+}
@@ -0,0 +1,231 @@
+package discord
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestCommandsEqual(t *testing.T) {
+	boolPointer := func(value bool) *bool { return &value }
+	snowflakePointer := func(value Snowflake) *Snowflake { return &value }
+	commandTypePointer := func(value ApplicationCommandType) *ApplicationCommandType { return &value }
+
+	base := func() ApplicationCommand {
+		return ApplicationCommand{
+			Name:        "ping",
+			Description: "Replies with pong",
+			Options: ApplicationCommandOptionList{
+				{
+					Name:         "channel",
+					Description:  "Channel to ping",
+					Type:         ApplicationCommandOptionTypeChannel,
+					ChannelTypes: ChannelTypeList{ChannelTypeGuildText, ChannelTypeGuildVoice},
+				},
+				{
+					Name:        "mode",
+					Description: "Ping mode",
+					Type:        ApplicationCommandOptionTypeString,
+					Choices: []ApplicationCommandOptionChoice{
+						{Name: "Fast", Value: json.RawMessage(`"fast"`)},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(command *ApplicationCommand)
+		want   bool
+	}{
+		{
+			name:   "identical",
+			modify: func(command *ApplicationCommand) {},
+			want:   true,
+		},
+		{
+			name: "ignores fields assigned by discord",
+			modify: func(command *ApplicationCommand) {
+				command.ID = snowflakePointer(1)
+				command.ApplicationID = snowflakePointer(2)
+				command.Version = 3
+			},
+			want: true,
+		},
+		{
+			name: "compares unset fields as their defaults",
+			modify: func(command *ApplicationCommand) {
+				command.Type = commandTypePointer(ApplicationCommandTypeChatInput)
+				command.DMPermission = boolPointer(true)
+				command.NameLocalizations = map[string]string{}
+				command.Options[0].Autocomplete = boolPointer(false)
+			},
+			want: true,
+		},
+		{
+			name: "ignores channel type order",
+			modify: func(command *ApplicationCommand) {
+				command.Options[0].ChannelTypes = ChannelTypeList{ChannelTypeGuildVoice, ChannelTypeGuildText}
+			},
+			want: true,
+		},
+		{
+			name: "ignores choice value formatting",
+			modify: func(command *ApplicationCommand) {
+				command.Options[1].Choices[0].Value = json.RawMessage(` "fast" `)
+			},
+			want: true,
+		},
+		{
+			name: "detects description changes",
+			modify: func(command *ApplicationCommand) {
+				command.Description = "Replies with pong!"
+			},
+			want: false,
+		},
+		{
+			name: "detects option order changes",
+			modify: func(command *ApplicationCommand) {
+				command.Options[0], command.Options[1] = command.Options[1], command.Options[0]
+			},
+			want: false,
+		},
+		{
+			name: "detects choice value changes",
+			modify: func(command *ApplicationCommand) {
+				command.Options[1].Choices[0].Value = json.RawMessage(`"slow"`)
+			},
+			want: false,
+		},
+		{
+			name: "detects dm permission changes",
+			modify: func(command *ApplicationCommand) {
+				command.DMPermission = boolPointer(false)
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := base()
+			tt.modify(&command)
+
+			if got := CommandsEqual(base(), command); got != tt.want {
+				t.Errorf("CommandsEqual() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanCommandSync(t *testing.T) {
+	snowflakePointer := func(value Snowflake) *Snowflake { return &value }
+	commandTypePointer := func(value ApplicationCommandType) *ApplicationCommandType { return &value }
+
+	existing := []ApplicationCommand{
+		{ID: snowflakePointer(1), Name: "ping", Description: "Replies with pong"},
+		{ID: snowflakePointer(2), Name: "echo", Description: "Repeats a message"},
+		{ID: snowflakePointer(3), Name: "old", Description: "No longer used"},
+	}
+
+	type action struct {
+		commandType CommandSyncActionType
+		name        string
+		commandID   Snowflake
+	}
+
+	tests := []struct {
+		name          string
+		desired       []ApplicationCommand
+		threshold     int
+		wantActions   []action
+		wantUnchanged []string
+		wantOverwrite bool
+	}{
+		{
+			name:          "unchanged",
+			desired:       existing,
+			wantUnchanged: []string{"ping", "echo", "old"},
+		},
+		{
+			name: "creates, edits and deletes",
+			desired: []ApplicationCommand{
+				{Name: "ping", Description: "Replies with pong"},
+				{Name: "echo", Description: "Repeats your message"},
+				{Name: "new", Description: "Newly added"},
+			},
+			wantActions: []action{
+				{commandType: CommandSyncActionEdit, name: "echo", commandID: 2},
+				{commandType: CommandSyncActionCreate, name: "new"},
+				{commandType: CommandSyncActionDelete, name: "old", commandID: 3},
+			},
+			wantUnchanged: []string{"ping"},
+		},
+		{
+			name: "matches commands by type and name",
+			desired: []ApplicationCommand{
+				{Name: "ping", Description: "Replies with pong"},
+				{Name: "echo", Description: "Repeats a message"},
+				{Name: "old", Type: commandTypePointer(ApplicationCommandTypeUser)},
+			},
+			wantActions: []action{
+				{commandType: CommandSyncActionCreate, name: "old"},
+				{commandType: CommandSyncActionDelete, name: "old", commandID: 3},
+			},
+			wantUnchanged: []string{"ping", "echo"},
+		},
+		{
+			name:      "overwrites at the threshold",
+			desired:   []ApplicationCommand{},
+			threshold: 3,
+			wantActions: []action{
+				{commandType: CommandSyncActionDelete, name: "ping", commandID: 1},
+				{commandType: CommandSyncActionDelete, name: "echo", commandID: 2},
+				{commandType: CommandSyncActionDelete, name: "old", commandID: 3},
+			},
+			wantOverwrite: true,
+		},
+		{
+			name:      "does not overwrite below the threshold",
+			desired:   existing[:2],
+			threshold: 3,
+			wantActions: []action{
+				{commandType: CommandSyncActionDelete, name: "old", commandID: 3},
+			},
+			wantUnchanged: []string{"ping", "echo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := PlanCommandSync(nil, existing, tt.desired, tt.threshold)
+
+			actions := make([]action, 0, len(plan.Actions))
+			for _, planAction := range plan.Actions {
+				got := action{commandType: planAction.Type, name: planAction.Name}
+				if planAction.CommandID != nil {
+					got.commandID = *planAction.CommandID
+				}
+
+				actions = append(actions, got)
+			}
+
+			if !slices.Equal(actions, tt.wantActions) {
+				t.Errorf("actions = %+v, want %+v", actions, tt.wantActions)
+			}
+
+			if !slices.Equal(plan.Unchanged, tt.wantUnchanged) {
+				t.Errorf("unchanged = %q, want %q", plan.Unchanged, tt.wantUnchanged)
+			}
+
+			if plan.Overwrite != tt.wantOverwrite {
+				t.Errorf("overwrite = %t, want %t", plan.Overwrite, tt.wantOverwrite)
+			}
+
+			if plan.HasChanges() != (len(tt.wantActions) > 0) {
+				t.Errorf("HasChanges() = %t, want %t", plan.HasChanges(), len(tt.wantActions) > 0)
+			}
+		})
+	}
+}
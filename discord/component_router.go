@@ -0,0 +1,364 @@
+package discord
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// component_router.go contains a router for message component and modal interactions that
+// matches custom IDs against patterns such as "ticket:close:{channelID}".
+
+const (
+	// MaxCustomIDLength is the maximum length of a component custom ID.
+	MaxCustomIDLength = 100
+
+	// ComponentCustomIDSeparator separates the segments of a custom ID pattern.
+	ComponentCustomIDSeparator = ":"
+
+	componentStateSignatureLength = 8
+)
+
+// ComponentHandler handles a message component or modal submit interaction. The returned response
+// may be nil if the handler has already responded to the interaction itself.
+type ComponentHandler func(ctx context.Context, interaction *Interaction, args ComponentArgs) (*InteractionResponse, error)
+
+// ComponentRouter routes component interactions to handlers by matching their custom ID.
+// Patterns are split into segments by ":" and segments in the form {name} capture the
+// value of that segment, so "ticket:close:{channelID}" matches "ticket:close:1234".
+// Routes are matched in the order they were registered.
+type ComponentRouter struct {
+	// NotFound is called when no route matches a custom ID. Optional.
+	NotFound ComponentHandler
+
+	routes []componentRoute
+
+	mu sync.RWMutex
+}
+
+type componentRoute struct {
+	handler       ComponentHandler
+	componentType *InteractionComponentType
+	segments      []string
+}
+
+// NewComponentRouter creates an empty component router.
+func NewComponentRouter() *ComponentRouter {
+	return &ComponentRouter{}
+}
+
+// Register registers a handler for a custom ID pattern for any component type.
+func (cr *ComponentRouter) Register(pattern string, handler ComponentHandler) *ComponentRouter {
+	return cr.register(nil, pattern, handler)
+}
+
+// RegisterType registers a handler for a custom ID pattern that only matches a specific component type.
+func (cr *ComponentRouter) RegisterType(componentType InteractionComponentType, pattern string, handler ComponentHandler) *ComponentRouter {
+	return cr.register(&componentType, pattern, handler)
+}
+
+func (cr *ComponentRouter) register(componentType *InteractionComponentType, pattern string, handler ComponentHandler) *ComponentRouter {
+	cr.mu.Lock()
+	cr.routes = append(cr.routes, componentRoute{
+		handler:       handler,
+		componentType: componentType,
+		segments:      strings.Split(pattern, ComponentCustomIDSeparator),
+	})
+	cr.mu.Unlock()
+
+	return cr
+}
+
+// Match returns the handler and captured parameters for a custom ID.
+func (cr *ComponentRouter) Match(componentType *InteractionComponentType, customID string) (ComponentHandler, map[string]string, bool) {
+	segments := strings.Split(customID, ComponentCustomIDSeparator)
+
+	cr.mu.RLock()
+	defer cr.mu.RUnlock()
+
+	for _, route := range cr.routes {
+		if route.componentType != nil && (componentType == nil || *route.componentType != *componentType) {
+			continue
+		}
+
+		params, ok := route.match(segments)
+		if ok {
+			return route.handler, params, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func (cr componentRoute) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(cr.segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+
+	for i, segment := range cr.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = segments[i]
+
+			continue
+		}
+
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// HandleInteraction dispatches a message component or modal submit interaction to its handler.
+// This matches InteractionHandler, so a router can be passed to NewInteractionServer directly.
+func (cr *ComponentRouter) HandleInteraction(ctx context.Context, interaction *Interaction) (*InteractionResponse, error) {
+	if interaction.Data == nil || (interaction.Type != InteractionTypeMessageComponent && interaction.Type != InteractionTypeModalSubmit) {
+		return nil, ErrUnknownComponent
+	}
+
+	handler, params, ok := cr.Match(interaction.Data.ComponentType, interaction.Data.CustomID)
+	if !ok {
+		if cr.NotFound == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownComponent, interaction.Data.CustomID)
+		}
+
+		handler = cr.NotFound
+	}
+
+	args := ComponentArgs{
+		Params:   params,
+		CustomID: interaction.Data.CustomID,
+		Values:   interaction.Data.Values,
+		Resolved: interaction.Data.Resolved,
+	}
+
+	if interaction.Data.ComponentType != nil {
+		args.ComponentType = *interaction.Data.ComponentType
+	}
+
+	return handler(ctx, interaction, args)
+}
+
+// ComponentArgs represents the data of a component interaction after its custom ID has been matched.
+type ComponentArgs struct {
+	Params        map[string]string
+	Resolved      *InteractionResolvedData
+	CustomID      string
+	Values        []string
+	ComponentType InteractionComponentType
+}
+
+// Param returns a parameter captured from the custom ID.
+func (ca ComponentArgs) Param(name string) string {
+	return ca.Params[name]
+}
+
+// ParamSnowflake returns a parameter captured from the custom ID as a Snowflake.
+func (ca ComponentArgs) ParamSnowflake(name string) (Snowflake, error) {
+	snowflake, err := parseSnowflake(ca.Params[name])
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse parameter %s: %w", name, err)
+	}
+
+	return snowflake, nil
+}
+
+// ValueIDs returns the selected values of a user, role, mentionable or channel select as Snowflakes.
+func (ca ComponentArgs) ValueIDs() []Snowflake {
+	ids := make([]Snowflake, 0, len(ca.Values))
+
+	for _, value := range ca.Values {
+		id, err := parseSnowflake(value)
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// Users returns the selected users of a user or mentionable select.
+func (ca ComponentArgs) Users() []User {
+	users := make([]User, 0, len(ca.Values))
+
+	if ca.Resolved == nil {
+		return users
+	}
+
+	for _, id := range ca.ValueIDs() {
+		if user, ok := ca.Resolved.Users[id]; ok {
+			users = append(users, user)
+		}
+	}
+
+	return users
+}
+
+// Members returns the selected members of a user or mentionable select. The member's User
+// will be populated from the resolved users.
+func (ca ComponentArgs) Members() []GuildMember {
+	members := make([]GuildMember, 0, len(ca.Values))
+
+	if ca.Resolved == nil {
+		return members
+	}
+
+	for _, id := range ca.ValueIDs() {
+		member, ok := ca.Resolved.Members[id]
+		if !ok {
+			continue
+		}
+
+		if member.User == nil {
+			if user, ok := ca.Resolved.Users[id]; ok {
+				member.User = &user
+			}
+		}
+
+		members = append(members, member)
+	}
+
+	return members
+}
+
+// Roles returns the selected roles of a role or mentionable select.
+func (ca ComponentArgs) Roles() []Role {
+	roles := make([]Role, 0, len(ca.Values))
+
+	if ca.Resolved == nil {
+		return roles
+	}
+
+	for _, id := range ca.ValueIDs() {
+		if role, ok := ca.Resolved.Roles[id]; ok {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// Channels returns the selected channels of a channel select.
+func (ca ComponentArgs) Channels() []Channel {
+	channels := make([]Channel, 0, len(ca.Values))
+
+	if ca.Resolved == nil {
+		return channels
+	}
+
+	for _, id := range ca.ValueIDs() {
+		if channel, ok := ca.Resolved.Channels[id]; ok {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels
+}
+
+// ComponentStateCodec packs small amounts of state into a custom ID. State is encoded as
+// base64 JSON with a truncated HMAC-SHA256 signature over the prefix and state, so it cannot be
+// tampered with by clients or moved to a custom ID with a different prefix.
+type ComponentStateCodec struct {
+	Key []byte
+}
+
+// NewComponentStateCodec creates a codec that signs state with the given key.
+func NewComponentStateCodec(key []byte) *ComponentStateCodec {
+	return &ComponentStateCodec{
+		Key: key,
+	}
+}
+
+// Encode encodes state and appends it to prefix, returning a custom ID. For example a prefix
+// of "ticket:close" can be matched by the pattern "ticket:close:{state}". Returns
+// ErrCustomIDTooLong if the custom ID exceeds 100 characters.
+func (csc *ComponentStateCodec) Encode(prefix string, state any) (string, error) {
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(csc.sign(prefix, payload))
+
+	customID := encoded
+	if prefix != "" {
+		customID = prefix + ComponentCustomIDSeparator + encoded
+	}
+
+	if len(customID) > MaxCustomIDLength {
+		return "", fmt.Errorf("%w: %d characters", ErrCustomIDTooLong, len(customID))
+	}
+
+	return customID, nil
+}
+
+// DecodeCustomID verifies and decodes the state of a custom ID produced by Encode into dst.
+func (csc *ComponentStateCodec) DecodeCustomID(customID string, dst any) error {
+	prefix, value, ok := cutLast(customID, ComponentCustomIDSeparator)
+	if !ok {
+		return csc.Decode("", customID, dst)
+	}
+
+	return csc.Decode(prefix, value, dst)
+}
+
+// Decode verifies and decodes state produced by Encode into dst. The prefix must be the one
+// passed to Encode, and the value the encoded state without the prefix, such as a parameter
+// captured by a ComponentRouter.
+func (csc *ComponentStateCodec) Decode(prefix, value string, dst any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(value, ".")
+	if !ok {
+		return ErrInvalidComponentState
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidComponentState
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return ErrInvalidComponentState
+	}
+
+	if !hmac.Equal(signature, csc.sign(prefix, payload)) {
+		return ErrInvalidComponentState
+	}
+
+	err = json.Unmarshal(payload, dst)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	return nil
+}
+
+// sign returns the signature of a prefix and payload. The prefix is length-prefixed so its
+// boundary with the payload cannot be shifted.
+func (csc *ComponentStateCodec) sign(prefix string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, csc.Key)
+	mac.Write(binary.AppendUvarint(nil, uint64(len(prefix))))
+	mac.Write([]byte(prefix))
+	mac.Write(payload)
+
+	return mac.Sum(nil)[:componentStateSignatureLength]
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
@@ -0,0 +1,550 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// components.go contains builders for message components, including the components used with
+// MessageFlagIsComponentsV2, and validation of component layouts before they are sent.
+
+// Component limits.
+const (
+	MaxActionRows                    = 5
+	MaxActionRowButtons              = 5
+	MaxComponentsV2                  = 40
+	MaxComponentsV2TextLength        = 4000
+	MaxSectionTextDisplays           = 3
+	MaxMediaGalleryItems             = 10
+	MaxButtonLabelLength             = 80
+	MaxSelectPlaceholderLength       = 150
+	MaxMediaDescriptionLength        = 1024
+	MaxSelectOptionLabelLength       = 100
+	MaxSelectOptionValueLength       = 100
+	MaxSelectOptionDescriptionLength = 100
+	componentAttachmentURLPrefix     = "attachment://"
+)
+
+// NewActionRow creates an action row containing the given components.
+func NewActionRow(components ...InteractionComponent) *InteractionComponent {
+	return &InteractionComponent{
+		Type:       InteractionComponentTypeActionRow,
+		Components: components,
+	}
+}
+
+// NewButton creates a button. Link buttons should use NewLinkButton instead.
+func NewButton(style InteractionComponentStyle, label, customID string) *InteractionComponent {
+	return &InteractionComponent{
+		Type:     InteractionComponentTypeButton,
+		Style:    style,
+		Label:    label,
+		CustomID: customID,
+	}
+}
+
+// NewLinkButton creates a button that opens a URL.
+func NewLinkButton(label, url string) *InteractionComponent {
+	return &InteractionComponent{
+		Type:  InteractionComponentTypeButton,
+		Style: InteractionComponentStyleLink,
+		Label: label,
+		URL:   url,
+	}
+}
+
+// NewPremiumButton creates a button that prompts the user to purchase a SKU. Premium buttons
+// have no label or custom ID and do not send an interaction when clicked.
+func NewPremiumButton(skuID Snowflake) *InteractionComponent {
+	return &InteractionComponent{
+		Type:  InteractionComponentTypeButton,
+		Style: InteractionComponentStylePremium,
+		SKUID: skuID,
+	}
+}
+
+// NewTextDisplay creates a text display containing markdown content.
+func NewTextDisplay(content string) *InteractionComponent {
+	return &InteractionComponent{
+		Type:    InteractionComponentTypeTextDisplay,
+		Content: content,
+	}
+}
+
+// NewSection creates a section with one to three text displays and an accessory,
+// which must be a button or thumbnail.
+func NewSection(accessory *InteractionComponent, texts ...string) *InteractionComponent {
+	section := &InteractionComponent{
+		Type:      InteractionComponentTypeSection,
+		Accessory: accessory,
+	}
+
+	for _, text := range texts {
+		section.AddComponent(*NewTextDisplay(text))
+	}
+
+	return section
+}
+
+// NewThumbnail creates a thumbnail, which can be used as a section accessory.
+func NewThumbnail(url string) *InteractionComponent {
+	return &InteractionComponent{
+		Type:  InteractionComponentTypeThumbnail,
+		Media: &MediaItem{URL: url},
+	}
+}
+
+// NewMediaGallery creates a media gallery with the given items.
+func NewMediaGallery(items ...InteractionComponentMediaGalleryItem) *InteractionComponent {
+	return &InteractionComponent{
+		Type:  InteractionComponentTypeMediaGallery,
+		Items: items,
+	}
+}
+
+// NewMediaGalleryItem creates a media gallery item.
+func NewMediaGalleryItem(url, description string, spoiler bool) InteractionComponentMediaGalleryItem {
+	return InteractionComponentMediaGalleryItem{
+		Media:       MediaItem{URL: url},
+		Description: description,
+		Spoiler:     spoiler,
+	}
+}
+
+// NewFileComponent creates a file component. The URL must reference an uploaded
+// file in the form attachment://filename.
+func NewFileComponent(url string) *InteractionComponent {
+	return &InteractionComponent{
+		Type: InteractionComponentTypeFile,
+		File: &MediaItem{URL: url},
+	}
+}
+
+// NewSeparator creates a separator.
+func NewSeparator(divider bool, spacing InteractionComponentSeparatorSpacing) *InteractionComponent {
+	return &InteractionComponent{
+		Type:    InteractionComponentTypeSeparator,
+		Divider: &divider,
+		Spacing: spacing,
+	}
+}
+
+// NewContainer creates a container that groups components with an optional accent color.
+func NewContainer(components ...InteractionComponent) *InteractionComponent {
+	return &InteractionComponent{
+		Type:       InteractionComponentTypeContainer,
+		Components: components,
+	}
+}
+
+func (ic *InteractionComponent) SetAccentColor(color uint32) *InteractionComponent {
+	ic.AccentColor = &color
+
+	return ic
+}
+
+func (ic *InteractionComponent) SetSpoiler(spoiler bool) *InteractionComponent {
+	ic.Spoiler = &spoiler
+
+	return ic
+}
+
+func (ic *InteractionComponent) SetAccessory(accessory *InteractionComponent) *InteractionComponent {
+	ic.Accessory = accessory
+
+	return ic
+}
+
+func (ic *InteractionComponent) SetDescription(description string) *InteractionComponent {
+	ic.Description = description
+
+	return ic
+}
+
+func (ic *InteractionComponent) SetSKUID(skuID Snowflake) *InteractionComponent {
+	ic.SKUID = skuID
+
+	return ic
+}
+
+// AddTextDisplay adds a text display to a container or section.
+func (ic *InteractionComponent) AddTextDisplay(content string) *InteractionComponent {
+	return ic.AddComponent(*NewTextDisplay(content))
+}
+
+// AddSection adds a section to a container.
+func (ic *InteractionComponent) AddSection(accessory *InteractionComponent, texts ...string) *InteractionComponent {
+	return ic.AddComponent(*NewSection(accessory, texts...))
+}
+
+// AddSeparator adds a separator to a container.
+func (ic *InteractionComponent) AddSeparator(divider bool, spacing InteractionComponentSeparatorSpacing) *InteractionComponent {
+	return ic.AddComponent(*NewSeparator(divider, spacing))
+}
+
+// AddMediaGalleryItem adds an item to a media gallery.
+func (ic *InteractionComponent) AddMediaGalleryItem(item InteractionComponentMediaGalleryItem) *InteractionComponent {
+	ic.Items = append(ic.Items, item)
+
+	return ic
+}
+
+// ComponentValidationError represents an invalid component at a path such as "components[0].accessory".
+type ComponentValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (e *ComponentValidationError) Error() string {
+	return e.Path + ": " + e.Reason
+}
+
+func (e *ComponentValidationError) Unwrap() error {
+	return ErrInvalidComponents
+}
+
+// componentValidator collects errors while walking a component tree.
+type componentValidator struct {
+	customIDs  map[string]bool
+	errs       []error
+	count      int
+	textLength int
+	isV2       bool
+}
+
+func (cv *componentValidator) addError(path, format string, args ...any) {
+	cv.errs = append(cv.errs, &ComponentValidationError{
+		Path:   path,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateComponents checks a message's components against discord's layout rules.
+// isComponentsV2 should be set if the message has MessageFlagIsComponentsV2. All errors are
+// returned joined, each as a *ComponentValidationError addressed by path.
+func ValidateComponents(components []InteractionComponent, isComponentsV2 bool) error {
+	validator := &componentValidator{
+		customIDs: make(map[string]bool),
+		isV2:      isComponentsV2,
+	}
+
+	if !isComponentsV2 && len(components) > MaxActionRows {
+		validator.addError("components", "must have at most %d action rows", MaxActionRows)
+	}
+
+	for i := range components {
+		path := fmt.Sprintf("components[%d]", i)
+		component := &components[i]
+
+		if !isComponentsV2 && component.Type != InteractionComponentTypeActionRow {
+			validator.addError(path, "top level components must be action rows without MessageFlagIsComponentsV2")
+
+			continue
+		}
+
+		switch component.Type {
+		case InteractionComponentTypeActionRow, InteractionComponentTypeSection, InteractionComponentTypeTextDisplay,
+			InteractionComponentTypeMediaGallery, InteractionComponentTypeFile, InteractionComponentTypeSeparator,
+			InteractionComponentTypeContainer:
+			validator.validate(path, component)
+		default:
+			validator.addError(path, "component type %d is not allowed at the top level", component.Type)
+		}
+	}
+
+	if isComponentsV2 {
+		if validator.count > MaxComponentsV2 {
+			validator.addError("components", "must have at most %d components in total, got %d", MaxComponentsV2, validator.count)
+		}
+
+		if validator.textLength > MaxComponentsV2TextLength {
+			validator.addError("components", "text displays must have at most %d characters in total, got %d", MaxComponentsV2TextLength, validator.textLength)
+		}
+	}
+
+	return errors.Join(validator.errs...)
+}
+
+// Validate checks a single top level component, such as one built with NewContainer, against
+// discord's layout rules. Components other than action rows are validated as MessageFlagIsComponentsV2.
+func (ic *InteractionComponent) Validate() error {
+	return ValidateComponents([]InteractionComponent{*ic}, ic.Type != InteractionComponentTypeActionRow)
+}
+
+func (cv *componentValidator) validate(path string, component *InteractionComponent) {
+	cv.count++
+
+	if component.CustomID != "" {
+		if len(component.CustomID) > MaxCustomIDLength {
+			cv.addError(path, "custom_id must be at most %d characters", MaxCustomIDLength)
+		}
+
+		if cv.customIDs[component.CustomID] {
+			cv.addError(path, "duplicate custom_id %q", component.CustomID)
+		}
+
+		cv.customIDs[component.CustomID] = true
+	}
+
+	switch component.Type {
+	case InteractionComponentTypeActionRow:
+		cv.validateActionRow(path, component)
+	case InteractionComponentTypeButton:
+		cv.validateButton(path, component)
+	case InteractionComponentTypeStringSelect, InteractionComponentTypeUserInput, InteractionComponentTypeRoleSelect,
+		InteractionComponentTypeMentionableSelect, InteractionComponentTypeChannelSelect:
+		cv.validateSelect(path, component)
+	case InteractionComponentTypeSection:
+		cv.validateSection(path, component)
+	case InteractionComponentTypeTextDisplay:
+		if component.Content == "" {
+			cv.addError(path, "text display content is required")
+		}
+
+		cv.textLength += utf8.RuneCountInString(component.Content)
+	case InteractionComponentTypeThumbnail:
+		if component.Media == nil || component.Media.URL == "" {
+			cv.addError(path+".media", "thumbnail media url is required")
+		}
+
+		if utf8.RuneCountInString(component.Description) > MaxMediaDescriptionLength {
+			cv.addError(path+".description", "must be at most %d characters", MaxMediaDescriptionLength)
+		}
+	case InteractionComponentTypeMediaGallery:
+		if len(component.Items) == 0 || len(component.Items) > MaxMediaGalleryItems {
+			cv.addError(path+".items", "media gallery must have 1-%d items, got %d", MaxMediaGalleryItems, len(component.Items))
+		}
+
+		for i, item := range component.Items {
+			if item.Media.URL == "" {
+				cv.addError(fmt.Sprintf("%s.items[%d].media", path, i), "media url is required")
+			}
+
+			if utf8.RuneCountInString(item.Description) > MaxMediaDescriptionLength {
+				cv.addError(fmt.Sprintf("%s.items[%d].description", path, i), "must be at most %d characters", MaxMediaDescriptionLength)
+			}
+		}
+	case InteractionComponentTypeFile:
+		if component.File == nil || !strings.HasPrefix(component.File.URL, componentAttachmentURLPrefix) {
+			cv.addError(path+".file", "file url must reference an attachment using attachment://")
+		}
+	case InteractionComponentTypeSeparator:
+		if component.Spacing != 0 && component.Spacing != InteractionComponentSeparatorSpacingSmall &&
+			component.Spacing != InteractionComponentSeparatorSpacingLarge {
+			cv.addError(path+".spacing", "invalid spacing %d", component.Spacing)
+		}
+	case InteractionComponentTypeContainer:
+		cv.validateContainer(path, component)
+	default:
+		cv.addError(path, "component type %d is not allowed in messages", component.Type)
+	}
+}
+
+func (cv *componentValidator) validateActionRow(path string, component *InteractionComponent) {
+	if len(component.Components) == 0 {
+		cv.addError(path, "action row must have at least one component")
+
+		return
+	}
+
+	buttons, selects := 0, 0
+
+	for i := range component.Components {
+		childPath := fmt.Sprintf("%s.components[%d]", path, i)
+		child := &component.Components[i]
+
+		switch child.Type {
+		case InteractionComponentTypeButton:
+			buttons++
+		case InteractionComponentTypeStringSelect, InteractionComponentTypeUserInput, InteractionComponentTypeRoleSelect,
+			InteractionComponentTypeMentionableSelect, InteractionComponentTypeChannelSelect:
+			selects++
+		default:
+			cv.addError(childPath, "component type %d is not allowed in an action row", child.Type)
+
+			continue
+		}
+
+		cv.validate(childPath, child)
+	}
+
+	if buttons > MaxActionRowButtons {
+		cv.addError(path, "action row must have at most %d buttons, got %d", MaxActionRowButtons, buttons)
+	}
+
+	if selects > 1 || (selects == 1 && buttons > 0) {
+		cv.addError(path, "action row with a select menu must not contain other components")
+	}
+}
+
+func (cv *componentValidator) validateButton(path string, component *InteractionComponent) {
+	if utf8.RuneCountInString(component.Label) > MaxButtonLabelLength {
+		cv.addError(path+".label", "must be at most %d characters", MaxButtonLabelLength)
+	}
+
+	switch component.Style {
+	case InteractionComponentStyleLink:
+		if component.URL == "" {
+			cv.addError(path, "link buttons require a url")
+		}
+
+		if component.CustomID != "" {
+			cv.addError(path, "link buttons must not have a custom_id")
+		}
+	case InteractionComponentStylePremium:
+		if component.SKUID.IsNil() {
+			cv.addError(path, "premium buttons require a sku_id")
+		}
+
+		if component.CustomID != "" || component.URL != "" || component.Label != "" || component.Emoji != nil {
+			cv.addError(path, "premium buttons must not have a custom_id, url, label or emoji")
+		}
+	case InteractionComponentStylePrimary, InteractionComponentStyleSecondary,
+		InteractionComponentStyleSuccess, InteractionComponentStyleDanger:
+		if component.CustomID == "" {
+			cv.addError(path, "buttons require a custom_id")
+		}
+
+		if component.URL != "" {
+			cv.addError(path, "only link buttons may have a url")
+		}
+	default:
+		cv.addError(path+".style", "invalid button style %d", component.Style)
+	}
+}
+
+func (cv *componentValidator) validateSelect(path string, component *InteractionComponent) {
+	if component.CustomID == "" {
+		cv.addError(path, "select menus require a custom_id")
+	}
+
+	if utf8.RuneCountInString(component.Placeholder) > MaxSelectPlaceholderLength {
+		cv.addError(path+".placeholder", "must be at most %d characters", MaxSelectPlaceholderLength)
+	}
+
+	if component.Type == InteractionComponentTypeStringSelect {
+		if len(component.Options) == 0 || len(component.Options) > MaxSelectOptions {
+			cv.addError(path+".options", "must have 1-%d options, got %d", MaxSelectOptions, len(component.Options))
+		}
+
+		for i, option := range component.Options {
+			optionPath := fmt.Sprintf("%s.options[%d]", path, i)
+
+			if option.Label == "" || utf8.RuneCountInString(option.Label) > MaxSelectOptionLabelLength {
+				cv.addError(optionPath+".label", "must be 1-%d characters", MaxSelectOptionLabelLength)
+			}
+
+			if option.Value == "" || utf8.RuneCountInString(option.Value) > MaxSelectOptionValueLength {
+				cv.addError(optionPath+".value", "must be 1-%d characters", MaxSelectOptionValueLength)
+			}
+
+			if utf8.RuneCountInString(option.Description) > MaxSelectOptionDescriptionLength {
+				cv.addError(optionPath+".description", "must be at most %d characters", MaxSelectOptionDescriptionLength)
+			}
+		}
+	}
+
+	if component.MinValues != nil && component.MaxValues != nil && *component.MinValues > *component.MaxValues {
+		cv.addError(path, "min_values must not be greater than max_values")
+	}
+}
+
+func (cv *componentValidator) validateSection(path string, component *InteractionComponent) {
+	if !cv.isV2 {
+		cv.addError(path, "sections require MessageFlagIsComponentsV2")
+	}
+
+	if len(component.Components) == 0 || len(component.Components) > MaxSectionTextDisplays {
+		cv.addError(path+".components", "section must have 1-%d text displays, got %d", MaxSectionTextDisplays, len(component.Components))
+	}
+
+	for i := range component.Components {
+		childPath := fmt.Sprintf("%s.components[%d]", path, i)
+		child := &component.Components[i]
+
+		if child.Type != InteractionComponentTypeTextDisplay {
+			cv.addError(childPath, "sections may only contain text displays, got type %d", child.Type)
+
+			continue
+		}
+
+		cv.validate(childPath, child)
+	}
+
+	if component.Accessory == nil {
+		cv.addError(path+".accessory", "section requires a button or thumbnail accessory")
+
+		return
+	}
+
+	if component.Accessory.Type != InteractionComponentTypeButton && component.Accessory.Type != InteractionComponentTypeThumbnail {
+		cv.addError(path+".accessory", "accessory must be a button or thumbnail, got type %d", component.Accessory.Type)
+
+		return
+	}
+
+	cv.validate(path+".accessory", component.Accessory)
+}
+
+func (cv *componentValidator) validateContainer(path string, component *InteractionComponent) {
+	if !cv.isV2 {
+		cv.addError(path, "containers require MessageFlagIsComponentsV2")
+	}
+
+	if len(component.Components) == 0 {
+		cv.addError(path+".components", "container must have at least one component")
+	}
+
+	for i := range component.Components {
+		childPath := fmt.Sprintf("%s.components[%d]", path, i)
+		child := &component.Components[i]
+
+		switch child.Type {
+		case InteractionComponentTypeActionRow, InteractionComponentTypeTextDisplay, InteractionComponentTypeSection,
+			InteractionComponentTypeMediaGallery, InteractionComponentTypeSeparator, InteractionComponentTypeFile:
+			cv.validate(childPath, child)
+		default:
+			cv.addError(childPath, "component type %d is not allowed in a container", child.Type)
+		}
+	}
+}
+
+// validateComponentsMessage validates the components of a message along with the message fields
+// that cannot be used with MessageFlagIsComponentsV2.
+func validateComponentsMessage(flags MessageFlags, content string, embeds []Embed, components []InteractionComponent) error {
+	isComponentsV2 := flags&MessageFlagIsComponentsV2 != 0
+
+	var errs []error
+
+	if isComponentsV2 {
+		if content != "" {
+			errs = append(errs, &ComponentValidationError{Path: "content", Reason: "content cannot be used with MessageFlagIsComponentsV2"})
+		}
+
+		if len(embeds) > 0 {
+			errs = append(errs, &ComponentValidationError{Path: "embeds", Reason: "embeds cannot be used with MessageFlagIsComponentsV2"})
+		}
+	}
+
+	errs = append(errs, ValidateComponents(components, isComponentsV2))
+
+	return errors.Join(errs...)
+}
+
+// ValidateComponents checks the message's components against discord's layout rules.
+func (m *MessageParams) ValidateComponents() error {
+	return validateComponentsMessage(m.Flags, m.Content, m.Embeds, m.Components)
+}
+
+// ValidateComponents checks the message's components against discord's layout rules.
+func (w *WebhookMessageParams) ValidateComponents() error {
+	return validateComponentsMessage(w.Flags, w.Content, w.Embeds, w.Components)
+}
+
+// ValidateComponents checks the response's components against discord's layout rules.
+// Modal responses should be validated with ModalBuilder instead.
+func (icd *InteractionCallbackData) ValidateComponents() error {
+	return validateComponentsMessage(MessageFlags(icd.Flags), icd.Content, icd.Embeds, icd.Components)
+}
@@ -4,6 +4,7 @@ package discord
 var (
 	EndpointDiscord = "https://discord.com"
 	EndpointCDN     = "https://cdn.discordapp.com"
+	EndpointMedia   = "https://media.discordapp.net"
 
 	EndpointGuilds     = "/guilds/"
 	EndpointChannels   = "/channels/"
@@ -19,6 +20,16 @@ var (
 	EndpointCDNChannelIcons = "/channel-icons/"
 	EndpointCDNBanners      = "/banners/"
 
+	EndpointCDNDiscoverySplashes   = "/discovery-splashes/"
+	EndpointCDNDefaultAvatars      = "/embed/avatars/"
+	EndpointCDNAvatarDecorations   = "/avatar-decoration-presets/"
+	EndpointCDNEmojis              = "/emojis/"
+	EndpointCDNStickers            = "/stickers/"
+	EndpointCDNRoleIcons           = "/role-icons/"
+	EndpointCDNGuildScheduledEvent = "/guild-events/"
+	EndpointCDNApplicationIcons    = "/app-icons/"
+	EndpointCDNTeamIcons           = "/team-icons/"
+
 	EndpointUser = func(userID string) string {
 		return EndpointUsers + userID
 	}
@@ -366,6 +377,17 @@ var (
 		return EndpointEntitlements(applicationID) + "/" + entitlementID
 	}
 
+	EndpointApplicationSKUs = func(applicationID string) string {
+		return EndpointApplication(applicationID) + "/skus"
+	}
+
+	EndpointSKUSubscriptions = func(skuID string) string {
+		return "/skus/" + skuID + "/subscriptions"
+	}
+	EndpointSKUSubscription = func(skuID, subscriptionID string) string {
+		return EndpointSKUSubscriptions(skuID) + "/" + subscriptionID
+	}
+
 	EndpointOAuth2             = "/oauth2"
 	EndpointOAuth2Me           = EndpointOAuth2 + "/@me"
 	EndpointOAuth2Applications = EndpointOAuth2 + "/applications"
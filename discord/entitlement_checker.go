@@ -0,0 +1,317 @@
+package discord
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// entitlement_checker.go contains a helper for checking if the user or guild of an interaction
+// has access to a SKU, and handlers that gate premium features behind one.
+
+// DefaultEntitlementCacheTTL is how long entitlements fetched from the API are cached by default.
+const DefaultEntitlementCacheTTL = 5 * time.Minute
+
+// minEntitlementCachePrune is the smallest cache size at which expired entries are removed.
+const minEntitlementCachePrune = 64
+
+// EntitlementChecker checks if users and guilds have an active entitlement to a SKU.
+// Entitlements sent with an interaction are checked first, falling back to entitlements fetched
+// with ListEntitlements, which are cached per user and guild.
+type EntitlementChecker struct {
+	Session *Session
+
+	// PremiumRequiredMessage is the content of the ephemeral message sent by Require handlers
+	// when access is missing, shown above a premium button for the SKU. If empty, the
+	// PremiumRequired interaction callback is used instead.
+	PremiumRequiredMessage string
+
+	cache map[entitlementOwner]entitlementCacheEntry
+
+	// pruneAt is the cache size at which expired entries are next removed.
+	pruneAt int
+
+	// CacheTTL is how long fetched entitlements are cached. If zero, DefaultEntitlementCacheTTL
+	// is used. If negative, entitlements are fetched on every check.
+	CacheTTL time.Duration
+
+	ApplicationID Snowflake
+
+	// ExcludeTestEntitlements ignores entitlements created in application test mode, so they
+	// do not grant access in production.
+	ExcludeTestEntitlements bool
+
+	// DisableFallback only checks the entitlements sent with an interaction.
+	DisableFallback bool
+
+	mu sync.Mutex
+}
+
+type entitlementOwner struct {
+	ID    Snowflake
+	Guild bool
+}
+
+type entitlementCacheEntry struct {
+	expiresAt    time.Time
+	entitlements []Entitlement
+}
+
+// NewEntitlementChecker creates an entitlement checker for an application.
+func NewEntitlementChecker(session *Session, applicationID Snowflake) *EntitlementChecker {
+	return &EntitlementChecker{
+		Session:       session,
+		ApplicationID: applicationID,
+		cache:         make(map[entitlementOwner]entitlementCacheEntry),
+	}
+}
+
+// Find returns a copy of the active entitlement to a SKU owned by the interaction's user or guild,
+// or nil if there is none. This can be used to consume the entitlement of a consumable SKU.
+func (ec *EntitlementChecker) Find(ctx context.Context, interaction *Interaction, skuID Snowflake) (*Entitlement, error) {
+	now := time.Now()
+
+	var userID Snowflake
+	if user := interaction.GetUser(); user != nil {
+		userID = user.ID
+	}
+
+	if entitlement := ec.find(interaction.Entitlements, skuID, userID, interaction.GuildID, now); entitlement != nil {
+		return entitlement, nil
+	}
+
+	if ec.DisableFallback || ec.Session == nil {
+		return nil, nil
+	}
+
+	if !userID.IsNil() {
+		entitlement, err := ec.findOwned(ctx, entitlementOwner{ID: userID}, skuID, userID, interaction.GuildID, now)
+		if err != nil || entitlement != nil {
+			return entitlement, err
+		}
+	}
+
+	if interaction.GuildID != nil {
+		return ec.findOwned(ctx, entitlementOwner{ID: *interaction.GuildID, Guild: true}, skuID, userID, interaction.GuildID, now)
+	}
+
+	return nil, nil
+}
+
+// HasSKU returns if the interaction's user or guild has an active entitlement to a SKU.
+func (ec *EntitlementChecker) HasSKU(ctx context.Context, interaction *Interaction, skuID Snowflake) (bool, error) {
+	entitlement, err := ec.Find(ctx, interaction, skuID)
+
+	return entitlement != nil, err
+}
+
+// UserHasSKU returns if a user has an active entitlement to a SKU, using cached entitlements.
+func (ec *EntitlementChecker) UserHasSKU(ctx context.Context, userID, skuID Snowflake) (bool, error) {
+	entitlement, err := ec.findOwned(ctx, entitlementOwner{ID: userID}, skuID, userID, nil, time.Now())
+
+	return entitlement != nil, err
+}
+
+// GuildHasSKU returns if a guild has an active entitlement to a SKU, using cached entitlements.
+func (ec *EntitlementChecker) GuildHasSKU(ctx context.Context, guildID, skuID Snowflake) (bool, error) {
+	entitlement, err := ec.findOwned(ctx, entitlementOwner{ID: guildID, Guild: true}, skuID, 0, &guildID, time.Now())
+
+	return entitlement != nil, err
+}
+
+// Consume marks an entitlement to a consumable SKU as consumed and removes its owner's cached
+// entitlements. The entitlement passed should be one returned by Find, and not one from the cache.
+func (ec *EntitlementChecker) Consume(ctx context.Context, entitlement *Entitlement) error {
+	err := ConsumeEntitlement(ctx, ec.Session, ec.ApplicationID, entitlement.ID)
+	if err != nil {
+		return err
+	}
+
+	entitlement.Consumed = true
+
+	ec.Invalidate(entitlement)
+
+	return nil
+}
+
+// Invalidate removes the cached entitlements of an entitlement's owner. This should be called
+// when receiving entitlement create, update and delete events.
+func (ec *EntitlementChecker) Invalidate(entitlement *Entitlement) {
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+
+	if entitlement.GuildID != nil {
+		delete(ec.cache, entitlementOwner{ID: *entitlement.GuildID, Guild: true})
+	}
+
+	if entitlement.UserID != nil {
+		delete(ec.cache, entitlementOwner{ID: *entitlement.UserID})
+	}
+}
+
+// Require wraps an interaction handler so it is only called if the interaction's user or guild
+// has the SKU. Otherwise, the interaction is answered with a premium required response.
+func (ec *EntitlementChecker) Require(skuID Snowflake, handler InteractionHandler) InteractionHandler {
+	return func(ctx context.Context, interaction *Interaction) (*InteractionResponse, error) {
+		ok, err := ec.HasSKU(ctx, interaction, skuID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return ec.PremiumRequiredResponse(skuID), nil
+		}
+
+		return handler(ctx, interaction)
+	}
+}
+
+// RequireCommand wraps a command handler so it is only called if the interaction's user or
+// guild has the SKU.
+func (ec *EntitlementChecker) RequireCommand(skuID Snowflake, handler CommandHandler) CommandHandler {
+	return func(ctx context.Context, interaction *Interaction, args CommandArgs) (*InteractionResponse, error) {
+		ok, err := ec.HasSKU(ctx, interaction, skuID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return ec.PremiumRequiredResponse(skuID), nil
+		}
+
+		return handler(ctx, interaction, args)
+	}
+}
+
+// RequireComponent wraps a component handler so it is only called if the interaction's user or
+// guild has the SKU.
+func (ec *EntitlementChecker) RequireComponent(skuID Snowflake, handler ComponentHandler) ComponentHandler {
+	return func(ctx context.Context, interaction *Interaction, args ComponentArgs) (*InteractionResponse, error) {
+		ok, err := ec.HasSKU(ctx, interaction, skuID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return ec.PremiumRequiredResponse(skuID), nil
+		}
+
+		return handler(ctx, interaction, args)
+	}
+}
+
+// PremiumRequiredResponse returns the response sent when access to a SKU is missing. This is an
+// ephemeral message with a premium button if PremiumRequiredMessage is set, otherwise the
+// PremiumRequired interaction callback.
+func (ec *EntitlementChecker) PremiumRequiredResponse(skuID Snowflake) *InteractionResponse {
+	if ec.PremiumRequiredMessage == "" {
+		return &InteractionResponse{
+			Type: InteractionCallbackTypePremiumRequired,
+		}
+	}
+
+	return &InteractionResponse{
+		Type: InteractionCallbackTypeChannelMessageSource,
+		Data: &InteractionCallbackData{
+			Content:    ec.PremiumRequiredMessage,
+			Components: []InteractionComponent{*NewActionRow(*NewPremiumButton(skuID))},
+			Flags:      uint32(MessageFlagEphemeral),
+		},
+	}
+}
+
+// find returns a copy of the first active entitlement to a SKU owned by the user or guild. A copy
+// is returned so callers cannot modify entitlements shared through the cache.
+func (ec *EntitlementChecker) find(entitlements []Entitlement, skuID, userID Snowflake, guildID *Snowflake, now time.Time) *Entitlement {
+	for i := range entitlements {
+		entitlement := &entitlements[i]
+
+		if entitlement.SkuID != skuID || !entitlement.OwnedBy(userID, guildID) || !entitlement.IsActive(now) {
+			continue
+		}
+
+		if ec.ExcludeTestEntitlements && entitlement.IsTest() {
+			continue
+		}
+
+		found := *entitlement
+
+		return &found
+	}
+
+	return nil
+}
+
+// findOwned searches the cached entitlements of an owner, fetching them if they have expired.
+func (ec *EntitlementChecker) findOwned(ctx context.Context, owner entitlementOwner, skuID, userID Snowflake, guildID *Snowflake, now time.Time) (*Entitlement, error) {
+	entitlements, err := ec.entitlements(ctx, owner, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.find(entitlements, skuID, userID, guildID, now), nil
+}
+
+// entitlements returns the entitlements of an owner from the cache, or fetches them.
+func (ec *EntitlementChecker) entitlements(ctx context.Context, owner entitlementOwner, now time.Time) ([]Entitlement, error) {
+	ttl := ec.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultEntitlementCacheTTL
+	}
+
+	ec.mu.Lock()
+	entry, ok := ec.cache[owner]
+	ec.mu.Unlock()
+
+	if ok && now.Before(entry.expiresAt) {
+		return entry.entitlements, nil
+	}
+
+	var userID, guildID *Snowflake
+	if owner.Guild {
+		guildID = &owner.ID
+	} else {
+		userID = &owner.ID
+	}
+
+	excludeEnded := true
+	excludeDeleted := true
+
+	entitlements, err := ListEntitlements(ctx, ec.Session, ec.ApplicationID, userID, nil, nil, nil, nil, guildID, &excludeEnded, &excludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		ec.mu.Lock()
+		if ec.cache == nil {
+			ec.cache = make(map[entitlementOwner]entitlementCacheEntry)
+		}
+
+		ec.pruneLocked(now)
+
+		ec.cache[owner] = entitlementCacheEntry{
+			entitlements: entitlements,
+			expiresAt:    now.Add(ttl),
+		}
+		ec.mu.Unlock()
+	}
+
+	return entitlements, nil
+}
+
+// pruneLocked removes expired entries from the cache, so owners that are not checked again do
+// not stay cached forever. This only runs once the cache has grown since the last prune.
+func (ec *EntitlementChecker) pruneLocked(now time.Time) {
+	if len(ec.cache) < ec.pruneAt {
+		return
+	}
+
+	for owner, entry := range ec.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(ec.cache, owner)
+		}
+	}
+
+	ec.pruneAt = max(2*len(ec.cache), minEntitlementCachePrune)
+}
@@ -2,6 +2,7 @@ package discord
 
 import "time"
 
+// Entitlement represents a user or guild's access to a premium offering in an application.
 type Entitlement struct {
 	UserID            *Snowflake      `json:"user_id,omitempty"`
 	GiftCodeFlags     *GiftCodeFlags  `json:"gift_code_flags,omitempty"`
@@ -62,3 +63,33 @@ const (
 	OwnerTypeGuild OwnerType = 1
 	OwnerTypeUser  OwnerType = 2
 )
+
+// IsTest returns if the entitlement was created for testing, either by a developer purchasing in
+// application test mode or with CreateTestEntitlement.
+func (e *Entitlement) IsTest() bool {
+	return e.Type == EntitlementTypeTestModePurchase
+}
+
+// IsActive returns if the entitlement grants access at the given time. Deleted and consumed
+// entitlements, and those outside of their start and end dates, are not active.
+func (e *Entitlement) IsActive(now time.Time) bool {
+	if e.Deleted || e.Consumed {
+		return false
+	}
+
+	if e.StartsAt != nil && now.Before(*e.StartsAt) {
+		return false
+	}
+
+	return e.EndsAt == nil || now.Before(*e.EndsAt)
+}
+
+// OwnedBy returns if the entitlement belongs to the user or guild. Guild entitlements are only
+// matched by guild, so a user who bought a guild subscription does not receive it elsewhere.
+func (e *Entitlement) OwnedBy(userID Snowflake, guildID *Snowflake) bool {
+	if e.GuildID != nil {
+		return guildID != nil && *e.GuildID == *guildID
+	}
+
+	return e.UserID != nil && *e.UserID == userID
+}
@@ -67,6 +67,20 @@ func ListEntitlements(ctx context.Context, session *Session, applicationID Snowf
 	return entitlements, nil
 }
 
+// GetEntitlement returns an entitlement of an application.
+func GetEntitlement(ctx context.Context, session *Session, applicationID, entitlementID Snowflake) (*Entitlement, error) {
+	endpoint := EndpointEntitlement(applicationID.String(), entitlementID.String())
+
+	var entitlement *Entitlement
+
+	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &entitlement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entitlement: %w", err)
+	}
+
+	return entitlement, nil
+}
+
 func CreateTestEntitlement(ctx context.Context, session *Session, applicationID Snowflake, entitlementParams EntitlementParams) (*Entitlement, error) {
 	endpoint := EndpointEntitlements(applicationID.String())
 
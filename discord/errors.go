@@ -8,8 +8,37 @@ import (
 )
 
 var (
-	ErrUnauthorized         = errors.New("improper token was passed")
-	ErrUnsupportedImageType = errors.New("unsupported image type given")
+	ErrUnauthorized          = errors.New("improper token was passed")
+	ErrUnsupportedImageType  = errors.New("unsupported image type given")
+	ErrInvalidSignature      = errors.New("invalid interaction signature")
+	ErrStaleTimestamp        = errors.New("interaction timestamp outside of allowed window")
+	ErrNoInteractionHandler  = errors.New("interaction server has no handler")
+	ErrUnknownCommand        = errors.New("no handler registered for command")
+	ErrInvalidBindTarget     = errors.New("invalid bind target")
+	ErrUnresolvedOption      = errors.New("option could not be resolved")
+	ErrMissingOption         = errors.New("required option was not passed")
+	ErrInvalidCommandStruct  = errors.New("invalid command struct")
+	ErrInvalidChoiceValue    = errors.New("invalid choice value")
+	ErrUnknownComponent      = errors.New("no handler registered for component")
+	ErrCustomIDTooLong       = errors.New("custom id exceeds maximum length")
+	ErrInvalidComponentState = errors.New("invalid component state")
+	ErrInvalidModal          = errors.New("invalid modal")
+	ErrInvalidComponents     = errors.New("invalid components")
+	ErrInteractionExpired    = errors.New("interaction token has expired")
+	ErrAcknowledgeExpired    = errors.New("interaction was not acknowledged in time")
+	ErrNotAcknowledged       = errors.New("interaction has not been acknowledged")
+	ErrInvalidMessage        = errors.New("invalid message")
+	ErrInvalidImageSize      = errors.New("image size must be a power of two between 16 and 4096")
+	ErrInvalidImageFormat    = errors.New("invalid image format")
+	ErrImageTooLarge         = errors.New("image exceeds maximum size")
+	ErrTooManyTags           = errors.New("too many forum tags")
+	ErrInvalidForumTag       = errors.New("invalid forum tag")
+	ErrMissingScope          = errors.New("access token is missing a required scope")
+	ErrNoRefreshToken        = errors.New("access token has no refresh token")
+	ErrNoAccessToken         = errors.New("token source has no access token")
+	ErrInvalidInteraction    = errors.New("invalid interaction")
+	ErrInvalidOptional       = errors.New("invalid optional field, create it with NewOptional, NewNullable or Null")
+	ErrRequestTooLarge       = errors.New("request body exceeds maximum size")
 )
 
 // RestError contains the error structure that is returned by discord.
@@ -168,6 +168,29 @@ type GuildRoleDelete struct {
 	RoleID  Snowflake `json:"role_id"`
 }
 
+// GuildScheduledEventCreate represents a guild scheduled event create event.
+type GuildScheduledEventCreate ScheduledEvent
+
+// GuildScheduledEventUpdate represents a guild scheduled event update event.
+type GuildScheduledEventUpdate ScheduledEvent
+
+// GuildScheduledEventDelete represents a guild scheduled event delete event.
+type GuildScheduledEventDelete ScheduledEvent
+
+// GuildScheduledEventUserAdd represents a user subscribing to a guild scheduled event.
+type GuildScheduledEventUserAdd struct {
+	EventID Snowflake `json:"guild_scheduled_event_id"`
+	UserID  Snowflake `json:"user_id"`
+	GuildID Snowflake `json:"guild_id"`
+}
+
+// GuildScheduledEventUserRemove represents a user unsubscribing from a guild scheduled event.
+type GuildScheduledEventUserRemove struct {
+	EventID Snowflake `json:"guild_scheduled_event_id"`
+	UserID  Snowflake `json:"user_id"`
+	GuildID Snowflake `json:"guild_id"`
+}
+
 // IntegrationCreate represents the integration create event.
 type IntegrationCreate Integration
 
@@ -290,6 +313,24 @@ type WebhookUpdate struct {
 	GuildID   Snowflake `json:"guild_id"`
 }
 
+// EntitlementCreate represents an entitlement create event.
+type EntitlementCreate Entitlement
+
+// EntitlementUpdate represents an entitlement update event.
+type EntitlementUpdate Entitlement
+
+// EntitlementDelete represents an entitlement delete event.
+type EntitlementDelete Entitlement
+
+// SubscriptionCreate represents a subscription create event.
+type SubscriptionCreate Subscription
+
+// SubscriptionUpdate represents a subscription update event.
+type SubscriptionUpdate Subscription
+
+// SubscriptionDelete represents a subscription delete event.
+type SubscriptionDelete Subscription
+
 // GuildJoinRequestDelete represents a guild join request delete event.
 type GuildJoinRequestDelete struct {
 	UserID  Snowflake `json:"user_id"`
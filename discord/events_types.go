@@ -63,6 +63,16 @@ const (
 	DiscordEventEntitlementUpdate = "ENTITLEMENT_UPDATE"
 	DiscordEventEntitlementDelete = "ENTITLEMENT_DELETE"
 
+	DiscordEventGuildScheduledEventCreate     = "GUILD_SCHEDULED_EVENT_CREATE"
+	DiscordEventGuildScheduledEventUpdate     = "GUILD_SCHEDULED_EVENT_UPDATE"
+	DiscordEventGuildScheduledEventDelete     = "GUILD_SCHEDULED_EVENT_DELETE"
+	DiscordEventGuildScheduledEventUserAdd    = "GUILD_SCHEDULED_EVENT_USER_ADD"
+	DiscordEventGuildScheduledEventUserRemove = "GUILD_SCHEDULED_EVENT_USER_REMOVE"
+
+	DiscordEventSubscriptionCreate = "SUBSCRIPTION_CREATE"
+	DiscordEventSubscriptionUpdate = "SUBSCRIPTION_UPDATE"
+	DiscordEventSubscriptionDelete = "SUBSCRIPTION_DELETE"
+
 	DiscordEventGuildJoin        = "GUILD_JOIN"
 	DiscordEventGuildAvailable   = "GUILD_AVAILABLE"
 	DiscordEventGuildLeave       = "GUILD_LEAVE"
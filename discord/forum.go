@@ -0,0 +1,176 @@
+package discord
+
+import (
+	"fmt"
+	"slices"
+)
+
+// forum.go contains the structures for forum and media channels and their posts.
+
+// ForumSortOrder represents the order posts are sorted in a forum channel.
+type ForumSortOrder uint8
+
+const (
+	ForumSortOrderLatestActivity ForumSortOrder = iota
+	ForumSortOrderCreationDate
+)
+
+// ForumLayout represents how posts are displayed in a forum channel.
+type ForumLayout uint8
+
+const (
+	ForumLayoutNotSet ForumLayout = iota
+	ForumLayoutListView
+	ForumLayoutGalleryView
+)
+
+// Forum channel flags.
+const (
+	ChannelFlagPinned                   int32 = 1 << 1
+	ChannelFlagRequireTag               int32 = 1 << 4
+	ChannelFlagHideMediaDownloadOptions int32 = 1 << 15
+)
+
+// Forum tag limits.
+const (
+	MaxForumAvailableTags = 20
+	MaxForumAppliedTags   = 5
+	MaxForumTagNameLength = 20
+)
+
+// ForumThreadMessageParams represents the first message of a forum post.
+type ForumThreadMessageParams struct {
+	AllowedMentions *MessageAllowedMentions `json:"allowed_mentions,omitempty"`
+	Content         string                  `json:"content,omitempty"`
+	Embeds          []Embed                 `json:"embeds,omitempty"`
+	Components      []InteractionComponent  `json:"components,omitempty"`
+	StickerIDs      []Snowflake             `json:"sticker_ids,omitempty"`
+	Files           []File                  `json:"-"`
+	Attachments     []MessageAttachment     `json:"attachments,omitempty"`
+	Flags           MessageFlags            `json:"flags,omitempty"`
+}
+
+// CreateForumPostParams represents the parameters for creating a post in a forum or media channel.
+type CreateForumPostParams struct {
+	AutoArchiveDuration *int32                   `json:"auto_archive_duration,omitempty"`
+	RateLimitPerUser    *int32                   `json:"rate_limit_per_user,omitempty"`
+	Name                string                   `json:"name"`
+	AppliedTags         []Snowflake              `json:"applied_tags,omitempty"`
+	Message             ForumThreadMessageParams `json:"message"`
+}
+
+// Validate checks the number of tags applied to the post.
+func (p CreateForumPostParams) Validate() error {
+	return validateAppliedTags(p.AppliedTags)
+}
+
+// ForumTagParams represents a tag sent when creating or modifying a forum or media channel.
+// Existing tags are sent with their ID, and new tags are created without one.
+type ForumTagParams struct {
+	ID        *Snowflake `json:"id,omitempty"`
+	EmojiID   *Snowflake `json:"emoji_id,omitempty"`
+	Name      string     `json:"name"`
+	EmojiName string     `json:"emoji_name,omitempty"`
+	Moderated bool       `json:"moderated"`
+}
+
+// Params returns the parameters that keep the tag when modifying a channel's available tags.
+func (ft ForumTag) Params() ForumTagParams {
+	id := ft.ID
+
+	return ForumTagParams{
+		ID:        &id,
+		EmojiID:   ft.EmojiID,
+		Name:      ft.Name,
+		EmojiName: ft.EmojiName,
+		Moderated: ft.Moderated,
+	}
+}
+
+// ForumPost represents a post created in a forum or media channel, along with its first message.
+type ForumPost struct {
+	Message *Message `json:"message,omitempty"`
+	Channel
+}
+
+// RequiresTag returns if posts in a forum or media channel must have a tag applied.
+func (c *Channel) RequiresTag() bool {
+	return c.Flags&ChannelFlagRequireTag != 0
+}
+
+// Pinned returns if a forum post is pinned.
+func (c *Channel) Pinned() bool {
+	return c.Flags&ChannelFlagPinned != 0
+}
+
+// ForumTag returns the available tag of a forum or media channel with the given ID, or nil if there is none.
+func (c *Channel) ForumTag(tagID Snowflake) *ForumTag {
+	for i := range c.AvailableTags {
+		if c.AvailableTags[i].ID == tagID {
+			return &c.AvailableTags[i]
+		}
+	}
+
+	return nil
+}
+
+// ForumTagByName returns the available tag of a forum or media channel with the given name, or nil if there is none.
+func (c *Channel) ForumTagByName(name string) *ForumTag {
+	for i := range c.AvailableTags {
+		if c.AvailableTags[i].Name == name {
+			return &c.AvailableTags[i]
+		}
+	}
+
+	return nil
+}
+
+// validateAvailableTags checks the number and names of the tags available in a forum.
+func validateAvailableTags(tags []ForumTagParams) error {
+	if len(tags) > MaxForumAvailableTags {
+		return fmt.Errorf("%w: forums can have at most %d available tags, got %d", ErrTooManyTags, MaxForumAvailableTags, len(tags))
+	}
+
+	for _, tag := range tags {
+		if tag.Name == "" || len([]rune(tag.Name)) > MaxForumTagNameLength {
+			return fmt.Errorf("%w: tag name must be between 1 and %d characters", ErrInvalidForumTag, MaxForumTagNameLength)
+		}
+	}
+
+	return nil
+}
+
+// validateAppliedTags checks the number of tags applied to a post.
+func validateAppliedTags(tagIDs []Snowflake) error {
+	if len(tagIDs) > MaxForumAppliedTags {
+		return fmt.Errorf("%w: posts can have at most %d applied tags, got %d", ErrTooManyTags, MaxForumAppliedTags, len(tagIDs))
+	}
+
+	return nil
+}
+
+// addTags returns the tags with the new tags appended, skipping any already present.
+func addTags(tagIDs, newTagIDs []Snowflake) []Snowflake {
+	result := slices.Clone(tagIDs)
+
+	for _, tagID := range newTagIDs {
+		if !slices.Contains(result, tagID) {
+			result = append(result, tagID)
+		}
+	}
+
+	return result
+}
+
+// removeTags returns the tags without any of the removed tags.
+func removeTags(tagIDs, removedTagIDs []Snowflake) []Snowflake {
+	result := make([]Snowflake, 0, len(tagIDs))
+
+	for _, tagID := range tagIDs {
+		if !slices.Contains(removedTagIDs, tagID) {
+			result = append(result, tagID)
+		}
+	}
+
+	return result
+}
@@ -142,26 +142,100 @@ type Guild struct {
 
 // GuildParams represents the parameters sent when modifying a guild.
 type GuildParam struct {
-	AFKChannelID                *Snowflake                  `json:"afk_channel_id,omitempty"`
-	SystemChannelFlags          *SystemChannelFlags         `json:"system_channel_flags,omitempty"`
-	VerificationLevel           *VerificationLevel          `json:"verification_level,omitempty"`
-	DefaultMessageNotifications *MessageNotificationLevel   `json:"default_message_notifications,omitempty"`
-	ExplicitContentFilter       *ExplicitContentFilterLevel `json:"explicit_content_filter,omitempty"`
-	Icon                        *string                     `json:"icon,omitempty"`
-	OwnerID                     *Snowflake                  `json:"owner_id,omitempty"`
-	Name                        *string                     `json:"name,omitempty"`
-	PremiumProgressBarEnabled   *bool                       `json:"premium_progress_bar_enabled,omitempty"`
-	Banner                      *string                     `json:"banner,omitempty"`
-	Splash                      *string                     `json:"splash,omitempty"`
-	AFKTimeout                  *int32                      `json:"afk_timeout,omitempty"`
-	SystemChannelID             *Snowflake                  `json:"system_channel_id,omitempty"`
-	Region                      *string                     `json:"region,omitempty"`
-	RulesChannelID              *Snowflake                  `json:"rules_channel_id,omitempty"`
-	PublicUpdatesChannelID      *Snowflake                  `json:"public_updates_channel_id,omitempty"`
-	PreferredLocale             *string                     `json:"preferred_locale,omitempty"`
-	DiscoverySplash             *string                     `json:"discovery_splash,omitempty"`
-	Description                 *string                     `json:"description,omitempty"`
-	Features                    StringList                  `json:"features,omitempty"`
+	AFKChannelID                Nullable[Snowflake]                  `json:"afk_channel_id,omitempty"`
+	SystemChannelFlags          Optional[SystemChannelFlags]         `json:"system_channel_flags,omitempty"`
+	VerificationLevel           Nullable[VerificationLevel]          `json:"verification_level,omitempty"`
+	DefaultMessageNotifications Nullable[MessageNotificationLevel]   `json:"default_message_notifications,omitempty"`
+	ExplicitContentFilter       Nullable[ExplicitContentFilterLevel] `json:"explicit_content_filter,omitempty"`
+	Icon                        Nullable[string]                     `json:"icon,omitempty"`
+	OwnerID                     Optional[Snowflake]                  `json:"owner_id,omitempty"`
+	Name                        Optional[string]                     `json:"name,omitempty"`
+	PremiumProgressBarEnabled   Optional[bool]                       `json:"premium_progress_bar_enabled,omitempty"`
+	Banner                      Nullable[string]                     `json:"banner,omitempty"`
+	Splash                      Nullable[string]                     `json:"splash,omitempty"`
+	AFKTimeout                  Optional[int32]                      `json:"afk_timeout,omitempty"`
+	SystemChannelID             Nullable[Snowflake]                  `json:"system_channel_id,omitempty"`
+	Region                      Nullable[string]                     `json:"region,omitempty"`
+	RulesChannelID              Nullable[Snowflake]                  `json:"rules_channel_id,omitempty"`
+	PublicUpdatesChannelID      Nullable[Snowflake]                  `json:"public_updates_channel_id,omitempty"`
+	SafetyAlertsChannelID       Nullable[Snowflake]                  `json:"safety_alerts_channel_id,omitempty"`
+	PreferredLocale             Nullable[string]                     `json:"preferred_locale,omitempty"`
+	DiscoverySplash             Nullable[string]                     `json:"discovery_splash,omitempty"`
+	Description                 Nullable[string]                     `json:"description,omitempty"`
+	Features                    Optional[StringList]                 `json:"features,omitempty"`
+}
+
+// WelcomeScreen represents the welcome screen shown to new members of a community guild.
+type WelcomeScreen struct {
+	Description     *string                `json:"description,omitempty"`
+	WelcomeChannels []WelcomeScreenChannel `json:"welcome_channels"`
+}
+
+// WelcomeScreenChannel represents a channel shown on a welcome screen.
+type WelcomeScreenChannel struct {
+	EmojiID     *Snowflake `json:"emoji_id,omitempty"`
+	EmojiName   *string    `json:"emoji_name,omitempty"`
+	Description string     `json:"description"`
+	ChannelID   Snowflake  `json:"channel_id"`
+}
+
+// WelcomeScreenParam represents the parameters sent when modifying a guild's welcome screen.
+type WelcomeScreenParam struct {
+	Enabled         Nullable[bool]                   `json:"enabled,omitempty"`
+	Description     Nullable[string]                 `json:"description,omitempty"`
+	WelcomeChannels Nullable[[]WelcomeScreenChannel] `json:"welcome_channels,omitempty"`
+}
+
+// GuildWidgetImageStyle represents the style of a guild widget image.
+type GuildWidgetImageStyle string
+
+const (
+	GuildWidgetImageStyleShield  GuildWidgetImageStyle = "shield"
+	GuildWidgetImageStyleBanner1 GuildWidgetImageStyle = "banner1"
+	GuildWidgetImageStyleBanner2 GuildWidgetImageStyle = "banner2"
+	GuildWidgetImageStyleBanner3 GuildWidgetImageStyle = "banner3"
+	GuildWidgetImageStyleBanner4 GuildWidgetImageStyle = "banner4"
+)
+
+// GuildWidget represents the public widget of a guild.
+type GuildWidget struct {
+	InstantInvite *string              `json:"instant_invite,omitempty"`
+	Name          string               `json:"name"`
+	Channels      []GuildWidgetChannel `json:"channels"`
+	Members       []GuildWidgetMember  `json:"members"`
+	ID            Snowflake            `json:"id"`
+	PresenceCount int32                `json:"presence_count"`
+}
+
+// GuildWidgetChannel represents a voice channel shown on a guild widget.
+type GuildWidgetChannel struct {
+	Name     string    `json:"name"`
+	ID       Snowflake `json:"id"`
+	Position int32     `json:"position"`
+}
+
+// GuildWidgetMember represents an online member shown on a guild widget. Member IDs and
+// discriminators are anonymized.
+type GuildWidgetMember struct {
+	ChannelID     *Snowflake     `json:"channel_id,omitempty"`
+	Username      string         `json:"username"`
+	Discriminator string         `json:"discriminator"`
+	Avatar        string         `json:"avatar"`
+	AvatarURL     string         `json:"avatar_url"`
+	Status        PresenceStatus `json:"status"`
+	ID            string         `json:"id"`
+}
+
+// GuildWidgetSettings represents the settings of a guild widget.
+type GuildWidgetSettings struct {
+	ChannelID *Snowflake `json:"channel_id,omitempty"`
+	Enabled   bool       `json:"enabled"`
+}
+
+// GuildWidgetParam represents the parameters sent when modifying a guild widget.
+type GuildWidgetParam struct {
+	Enabled   Optional[bool]      `json:"enabled,omitempty"`
+	ChannelID Nullable[Snowflake] `json:"channel_id,omitempty"`
 }
 
 // AuditLogs returns all audit logs matching query.
@@ -188,7 +262,7 @@ func (g *Guild) Bans(ctx context.Context, session *Session) ([]GuildBan, error)
 // CloneChannel creates a copy of the target channel.
 // reason: Reason for creating the channel.
 func (g *Guild) CloneChannel(ctx context.Context, session *Session, channel *Channel, reason *string) (*Channel, error) {
-	return g.CreateChannel(ctx, session, ChannelParams{
+	return g.CreateChannel(ctx, session, CreateChannelParams{
 		Name:                 channel.Name,
 		Type:                 channel.Type,
 		Topic:                channel.Topic,
@@ -196,6 +270,8 @@ func (g *Guild) CloneChannel(ctx context.Context, session *Session, channel *Cha
 		UserLimit:            channel.UserLimit,
 		RateLimitPerUser:     channel.RateLimitPerUser,
 		Position:             channel.Position,
+		RTCRegion:            channel.RTCRegion,
+		VideoQualityMode:     channel.VideoQualityMode,
 		PermissionOverwrites: channel.PermissionOverwrites,
 		ParentID:             channel.ParentID,
 		NSFW:                 channel.NSFW,
@@ -205,7 +281,7 @@ func (g *Guild) CloneChannel(ctx context.Context, session *Session, channel *Cha
 // CreateChannel creates a channel.
 // channelArg: Parameters passed for creating a channel.
 // reason: Reason for creating the channel.
-func (g *Guild) CreateChannel(ctx context.Context, session *Session, channelParams ChannelParams, reason *string) (*Channel, error) {
+func (g *Guild) CreateChannel(ctx context.Context, session *Session, channelParams CreateChannelParams, reason *string) (*Channel, error) {
 	return CreateGuildChannel(ctx, session, g.ID, channelParams, reason)
 }
 
@@ -220,7 +296,7 @@ func (g *Guild) CreateCustomEmoji(ctx context.Context, session *Session, name st
 		Roles: roles,
 	}
 
-	imageData, err := bytesToBase64Data(image)
+	imageData, err := EncodeImage(image, ImageUsageEmoji)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode emoji: %w", err)
 	}
@@ -359,12 +435,13 @@ type GuildMember struct {
 
 // GuildMemberParams represents the arguments used to modify a guild member.
 type GuildMemberParams struct {
-	Nick                       *string     `json:"nick,omitempty"`
-	Deaf                       *bool       `json:"deaf,omitempty"`
-	Mute                       *bool       `json:"mute,omitempty"`
-	ChannelID                  *Snowflake  `json:"channel_id,omitempty"`
-	CommunicationDisabledUntil *time.Time  `json:"communication_disabled_until,omitempty"`
-	Roles                      []Snowflake `json:"roles,omitempty"`
+	Nick                       Nullable[string]      `json:"nick,omitempty"`
+	Deaf                       Optional[bool]        `json:"deaf,omitempty"`
+	Mute                       Optional[bool]        `json:"mute,omitempty"`
+	ChannelID                  Nullable[Snowflake]   `json:"channel_id,omitempty"`
+	CommunicationDisabledUntil Nullable[time.Time]   `json:"communication_disabled_until,omitempty"`
+	Roles                      Optional[[]Snowflake] `json:"roles,omitempty"`
+	Flags                      Optional[int32]       `json:"flags,omitempty"`
 }
 
 // AddRoles adds roles to a guild member.
@@ -403,7 +480,7 @@ func (gm *GuildMember) AddRoles(ctx context.Context, session *Session, roles []S
 		newRoles = append(newRoles, roleID)
 	}
 
-	return gm.Edit(ctx, session, GuildMemberParams{Roles: newRoles}, reason)
+	return gm.Edit(ctx, session, GuildMemberParams{Roles: NewOptional(newRoles)}, reason)
 }
 
 // Ban bans the guild member from the guild.
@@ -442,7 +519,7 @@ func (gm *GuildMember) Kick(ctx context.Context, session *Session, reason *strin
 // channelID: Channel to move the user to, if nil they are removed from voice.
 // reason: Reason for moving the guild member
 func (gm *GuildMember) MoveTo(ctx context.Context, session *Session, channelID *Snowflake, reason *string) error {
-	return gm.Edit(ctx, session, GuildMemberParams{ChannelID: channelID}, reason)
+	return gm.Edit(ctx, session, GuildMemberParams{ChannelID: NullableFromPointer(channelID)}, reason)
 }
 
 // RemoveRoles removes roles from a guild member.
@@ -490,7 +567,7 @@ func (gm *GuildMember) RemoveRoles(ctx context.Context, session *Session, roles
 		newRoles = append(newRoles, roleID)
 	}
 
-	return gm.Edit(ctx, session, GuildMemberParams{Roles: newRoles}, reason)
+	return gm.Edit(ctx, session, GuildMemberParams{Roles: NewOptional(newRoles)}, reason)
 }
 
 // Send sends a DM message to a user. This will create a DMChannel if one is not present.
@@ -532,8 +609,8 @@ type GuildPruneParam struct {
 
 // ModifyCurrentMemberParams represents the parameters used to modify the current member.
 type ModifyCurrentMemberParams struct {
-	Nick   *string `json:"nick,omitempty"`
-	Avatar *string `json:"avatar,omitempty"`
-	Banner *string `json:"banner,omitempty"`
-	Bio    *string `json:"bio,omitempty"`
+	Nick   Nullable[string] `json:"nick,omitempty"`
+	Avatar Nullable[string] `json:"avatar,omitempty"`
+	Banner Nullable[string] `json:"banner,omitempty"`
+	Bio    Nullable[string] `json:"bio,omitempty"`
 }
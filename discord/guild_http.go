@@ -2,6 +2,7 @@ package discord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -90,7 +91,12 @@ func GetGuildChannels(ctx context.Context, session *Session, guildID Snowflake)
 	return channels, nil
 }
 
-func CreateGuildChannel(ctx context.Context, session *Session, guildID Snowflake, channelParams ChannelParams, reason *string) (*Channel, error) {
+func CreateGuildChannel(ctx context.Context, session *Session, guildID Snowflake, channelParams CreateChannelParams, reason *string) (*Channel, error) {
+	err := channelParams.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	endpoint := EndpointGuildChannels(guildID.String())
 
 	headers := http.Header{}
@@ -101,7 +107,7 @@ func CreateGuildChannel(ctx context.Context, session *Session, guildID Snowflake
 
 	var channel *Channel
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, channelParams, headers, &channel)
+	err = session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, channelParams, headers, &channel)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create guild channel: %w", err)
 	}
@@ -427,7 +433,7 @@ func ModifyGuildRolePositions(ctx context.Context, session *Session, guildID Sno
 	return roles, nil
 }
 
-func ModifyGuildRole(ctx context.Context, session *Session, guildID, roleID Snowflake, roleArg Role, reason *string) (*Role, error) {
+func ModifyGuildRole(ctx context.Context, session *Session, guildID, roleID Snowflake, roleArg RoleParams, reason *string) (*Role, error) {
 	endpoint := EndpointGuildRole(guildID.String(), roleID.String())
 
 	headers := http.Header{}
@@ -593,12 +599,12 @@ func GetGuildVanityURL(ctx context.Context, session *Session, guildID Snowflake)
 }
 
 // GetGuildWidgetImage gets the guild widget image.
-func GetGuildWidgetImage(ctx context.Context, session *Session, guildID Snowflake, style *string) ([]byte, error) {
+func GetGuildWidgetImage(ctx context.Context, session *Session, guildID Snowflake, style *GuildWidgetImageStyle) ([]byte, error) {
 	endpoint := EndpointGuildWidgetImage(guildID.String())
 
 	params := url.Values{}
 	if style != nil {
-		params.Add("style", *style)
+		params.Add("style", string(*style))
 	}
 
 	if len(params) > 0 {
@@ -616,10 +622,10 @@ func GetGuildWidgetImage(ctx context.Context, session *Session, guildID Snowflak
 }
 
 // GetGuildWelcomeScreen gets the guild's welcome screen.
-func GetGuildWelcomeScreen(ctx context.Context, session *Session, guildID Snowflake) (any, error) {
+func GetGuildWelcomeScreen(ctx context.Context, session *Session, guildID Snowflake) (*WelcomeScreen, error) {
 	endpoint := EndpointGuildWelcomeScreen(guildID.String())
 
-	var welcomeScreen any
+	var welcomeScreen *WelcomeScreen
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &welcomeScreen)
 	if err != nil {
@@ -630,7 +636,7 @@ func GetGuildWelcomeScreen(ctx context.Context, session *Session, guildID Snowfl
 }
 
 // ModifyGuildWelcomeScreen modifies the guild's welcome screen.
-func ModifyGuildWelcomeScreen(ctx context.Context, session *Session, guildID Snowflake, params any, reason *string) (any, error) {
+func ModifyGuildWelcomeScreen(ctx context.Context, session *Session, guildID Snowflake, params WelcomeScreenParam, reason *string) (*WelcomeScreen, error) {
 	endpoint := EndpointGuildWelcomeScreen(guildID.String())
 
 	headers := http.Header{}
@@ -638,7 +644,7 @@ func ModifyGuildWelcomeScreen(ctx context.Context, session *Session, guildID Sno
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var welcomeScreen any
+	var welcomeScreen *WelcomeScreen
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPatch, endpoint, params, headers, &welcomeScreen)
 	if err != nil {
@@ -649,10 +655,10 @@ func ModifyGuildWelcomeScreen(ctx context.Context, session *Session, guildID Sno
 }
 
 // GetGuildWidget gets the guild widget.
-func GetGuildWidget(ctx context.Context, session *Session, guildID Snowflake) (any, error) {
+func GetGuildWidget(ctx context.Context, session *Session, guildID Snowflake) (*GuildWidget, error) {
 	endpoint := EndpointGuildWidgetJSON(guildID.String())
 
-	var widget any
+	var widget *GuildWidget
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &widget)
 	if err != nil {
@@ -663,7 +669,7 @@ func GetGuildWidget(ctx context.Context, session *Session, guildID Snowflake) (a
 }
 
 // ModifyGuildWidget modifies the guild widget.
-func ModifyGuildWidget(ctx context.Context, session *Session, guildID Snowflake, params any, reason *string) (any, error) {
+func ModifyGuildWidget(ctx context.Context, session *Session, guildID Snowflake, params GuildWidgetParam, reason *string) (*GuildWidgetSettings, error) {
 	endpoint := EndpointGuildWidget(guildID.String())
 
 	headers := http.Header{}
@@ -671,7 +677,7 @@ func ModifyGuildWidget(ctx context.Context, session *Session, guildID Snowflake,
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var widget any
+	var widget *GuildWidgetSettings
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPatch, endpoint, params, headers, &widget)
 	if err != nil {
@@ -682,10 +688,10 @@ func ModifyGuildWidget(ctx context.Context, session *Session, guildID Snowflake,
 }
 
 // GetGuildWidgetSettings gets the guild widget settings.
-func GetGuildWidgetSettings(ctx context.Context, session *Session, guildID Snowflake) (any, error) {
+func GetGuildWidgetSettings(ctx context.Context, session *Session, guildID Snowflake) (*GuildWidgetSettings, error) {
 	endpoint := EndpointGuildWidget(guildID.String())
 
-	var settings any
+	var settings *GuildWidgetSettings
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &settings)
 	if err != nil {
@@ -697,6 +703,56 @@ func GetGuildWidgetSettings(ctx context.Context, session *Session, guildID Snowf
 
 // AddGuildMember adds a guild member using an OAuth2 access token.
 func AddGuildMember(ctx context.Context, session *Session, guildID, userID Snowflake, accessToken string, nick *string, roles []Snowflake, mute, deaf *bool) (any, error) {
+	response, err := addGuildMember(ctx, session, guildID, userID, accessToken, nick, roles, mute, deaf)
+	if err != nil {
+		return nil, err
+	}
+
+	var member any
+
+	if len(response) > 0 {
+		err = json.Unmarshal(response, &member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return member, nil
+}
+
+// AddGuildMemberWithTokenSource adds a user to a guild, using their access token with the
+// guilds.join scope. The session must be authorized as a bot in the guild. The user's access
+// token is refreshed if it has expired. Returns nil if the user is already a member of the guild.
+func AddGuildMemberWithTokenSource(ctx context.Context, session *Session, guildID, userID Snowflake, user *OAuth2TokenSource, nick *string, roles []Snowflake, mute, deaf *bool) (*GuildMember, error) {
+	accessToken, err := user.AccessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user access token: %w", err)
+	}
+
+	if !accessToken.HasScope(OAuth2ScopeGuildsJoin) {
+		return nil, fmt.Errorf("failed to add guild member: %w: %s", ErrMissingScope, OAuth2ScopeGuildsJoin)
+	}
+
+	response, err := addGuildMember(ctx, session, guildID, userID, accessToken.AccessToken, nick, roles, mute, deaf)
+	if err != nil {
+		return nil, err
+	}
+
+	var member *GuildMember
+
+	if len(response) > 0 {
+		err = json.Unmarshal(response, &member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return member, nil
+}
+
+// addGuildMember adds a guild member and returns the response, which is empty if the user is
+// already a member of the guild.
+func addGuildMember(ctx context.Context, session *Session, guildID, userID Snowflake, accessToken string, nick *string, roles []Snowflake, mute, deaf *bool) ([]byte, error) {
 	endpoint := EndpointGuildMember(guildID.String(), userID.String())
 
 	params := struct {
@@ -713,14 +769,17 @@ func AddGuildMember(ctx context.Context, session *Session, guildID, userID Snowf
 		Deaf:        deaf,
 	}
 
-	var member any
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodPut, endpoint, params, nil, &member)
+	response, err := session.Interface.Fetch(ctx, session, http.MethodPut, endpoint, "application/json", body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add guild member: %w", err)
 	}
 
-	return member, nil
+	return response, nil
 }
 
 // ListVoiceRegions lists voice regions available to guilds.
@@ -792,7 +851,7 @@ func ModifyUserVoiceState(ctx context.Context, session *Session, guildID, userID
 }
 
 // ListScheduledEventsForGuild lists scheduled events for a guild.
-func ListScheduledEventsForGuild(ctx context.Context, session *Session, guildID Snowflake, withUserCount *bool) ([]any, error) {
+func ListScheduledEventsForGuild(ctx context.Context, session *Session, guildID Snowflake, withUserCount *bool) ([]ScheduledEvent, error) {
 	endpoint := EndpointGuildScheduledEvents(guildID.String())
 
 	params := url.Values{}
@@ -804,7 +863,7 @@ func ListScheduledEventsForGuild(ctx context.Context, session *Session, guildID
 		endpoint += "?" + params.Encode()
 	}
 
-	var events []any
+	var events []ScheduledEvent
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &events)
 	if err != nil {
@@ -815,7 +874,7 @@ func ListScheduledEventsForGuild(ctx context.Context, session *Session, guildID
 }
 
 // CreateGuildScheduledEvent creates a scheduled event for a guild.
-func CreateGuildScheduledEvent(ctx context.Context, session *Session, guildID Snowflake, params any, reason *string) (any, error) {
+func CreateGuildScheduledEvent(ctx context.Context, session *Session, guildID Snowflake, params CreateScheduledEventParams, reason *string) (*ScheduledEvent, error) {
 	endpoint := EndpointGuildScheduledEvents(guildID.String())
 
 	headers := http.Header{}
@@ -823,7 +882,7 @@ func CreateGuildScheduledEvent(ctx context.Context, session *Session, guildID Sn
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var event any
+	var event *ScheduledEvent
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, params, headers, &event)
 	if err != nil {
@@ -834,7 +893,7 @@ func CreateGuildScheduledEvent(ctx context.Context, session *Session, guildID Sn
 }
 
 // GetGuildScheduledEvent gets a scheduled event for a guild.
-func GetGuildScheduledEvent(ctx context.Context, session *Session, guildID, eventID Snowflake, withUserCount *bool) (any, error) {
+func GetGuildScheduledEvent(ctx context.Context, session *Session, guildID, eventID Snowflake, withUserCount *bool) (*ScheduledEvent, error) {
 	endpoint := EndpointGuildScheduledEvent(guildID.String(), eventID.String())
 
 	params := url.Values{}
@@ -846,7 +905,7 @@ func GetGuildScheduledEvent(ctx context.Context, session *Session, guildID, even
 		endpoint += "?" + params.Encode()
 	}
 
-	var event any
+	var event *ScheduledEvent
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &event)
 	if err != nil {
@@ -857,7 +916,7 @@ func GetGuildScheduledEvent(ctx context.Context, session *Session, guildID, even
 }
 
 // ModifyGuildScheduledEvent modifies a scheduled event for a guild.
-func ModifyGuildScheduledEvent(ctx context.Context, session *Session, guildID, eventID Snowflake, params any, reason *string) (any, error) {
+func ModifyGuildScheduledEvent(ctx context.Context, session *Session, guildID, eventID Snowflake, params ModifyScheduledEventParams, reason *string) (*ScheduledEvent, error) {
 	endpoint := EndpointGuildScheduledEvent(guildID.String(), eventID.String())
 
 	headers := http.Header{}
@@ -865,7 +924,7 @@ func ModifyGuildScheduledEvent(ctx context.Context, session *Session, guildID, e
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var event any
+	var event *ScheduledEvent
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPatch, endpoint, params, headers, &event)
 	if err != nil {
@@ -888,7 +947,7 @@ func DeleteGuildScheduledEvent(ctx context.Context, session *Session, guildID, e
 }
 
 // GetGuildScheduledEventUsers gets users who have responded to a scheduled event.
-func GetGuildScheduledEventUsers(ctx context.Context, session *Session, guildID, eventID Snowflake, limit *int, before, after *Snowflake) ([]any, error) {
+func GetGuildScheduledEventUsers(ctx context.Context, session *Session, guildID, eventID Snowflake, limit *int, before, after *Snowflake, withMember *bool) ([]ScheduledEventUser, error) {
 	endpoint := EndpointGuildScheduledEventUsers(guildID.String(), eventID.String())
 
 	params := url.Values{}
@@ -896,6 +955,10 @@ func GetGuildScheduledEventUsers(ctx context.Context, session *Session, guildID,
 		params.Add("limit", strconv.Itoa(*limit))
 	}
 
+	if withMember != nil {
+		params.Add("with_member", strconv.FormatBool(*withMember))
+	}
+
 	if before != nil {
 		params.Add("before", before.String())
 	}
@@ -908,7 +971,7 @@ func GetGuildScheduledEventUsers(ctx context.Context, session *Session, guildID,
 		endpoint += "?" + params.Encode()
 	}
 
-	var users []any
+	var users []ScheduledEventUser
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &users)
 	if err != nil {
@@ -919,10 +982,10 @@ func GetGuildScheduledEventUsers(ctx context.Context, session *Session, guildID,
 }
 
 // GetGuildTemplate gets a guild template.
-func GetGuildTemplate(ctx context.Context, session *Session, templateCode string) (any, error) {
+func GetGuildTemplate(ctx context.Context, session *Session, templateCode string) (*GuildTemplate, error) {
 	endpoint := "/guilds/templates/" + templateCode
 
-	var template any
+	var template *GuildTemplate
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &template)
 	if err != nil {
@@ -933,10 +996,10 @@ func GetGuildTemplate(ctx context.Context, session *Session, templateCode string
 }
 
 // CreateGuildFromTemplate creates a guild from a template.
-func CreateGuildFromTemplate(ctx context.Context, session *Session, templateCode string, params any) (any, error) {
+func CreateGuildFromTemplate(ctx context.Context, session *Session, templateCode string, params CreateGuildFromTemplateParams) (*Guild, error) {
 	endpoint := "/guilds/templates/" + templateCode
 
-	var guild any
+	var guild *Guild
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, params, nil, &guild)
 	if err != nil {
@@ -947,10 +1010,10 @@ func CreateGuildFromTemplate(ctx context.Context, session *Session, templateCode
 }
 
 // GetGuildTemplates gets the guild templates for a guild.
-func GetGuildTemplates(ctx context.Context, session *Session, guildID Snowflake) ([]any, error) {
+func GetGuildTemplates(ctx context.Context, session *Session, guildID Snowflake) ([]GuildTemplate, error) {
 	endpoint := EndpointGuildTemplates(guildID.String())
 
-	var templates []any
+	var templates []GuildTemplate
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &templates)
 	if err != nil {
@@ -961,7 +1024,7 @@ func GetGuildTemplates(ctx context.Context, session *Session, guildID Snowflake)
 }
 
 // CreateGuildTemplate creates a template for a guild.
-func CreateGuildTemplate(ctx context.Context, session *Session, guildID Snowflake, params any, reason *string) (any, error) {
+func CreateGuildTemplate(ctx context.Context, session *Session, guildID Snowflake, params CreateGuildTemplateParams, reason *string) (*GuildTemplate, error) {
 	endpoint := EndpointGuildTemplates(guildID.String())
 
 	headers := http.Header{}
@@ -969,7 +1032,7 @@ func CreateGuildTemplate(ctx context.Context, session *Session, guildID Snowflak
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var template any
+	var template *GuildTemplate
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPost, endpoint, params, headers, &template)
 	if err != nil {
@@ -980,7 +1043,7 @@ func CreateGuildTemplate(ctx context.Context, session *Session, guildID Snowflak
 }
 
 // SyncGuildTemplate syncs a guild template.
-func SyncGuildTemplate(ctx context.Context, session *Session, guildID Snowflake, templateCode string, reason *string) (any, error) {
+func SyncGuildTemplate(ctx context.Context, session *Session, guildID Snowflake, templateCode string, reason *string) (*GuildTemplate, error) {
 	endpoint := EndpointGuildTemplate(guildID.String(), templateCode)
 
 	headers := http.Header{}
@@ -988,7 +1051,7 @@ func SyncGuildTemplate(ctx context.Context, session *Session, guildID Snowflake,
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var template any
+	var template *GuildTemplate
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPut, endpoint, nil, headers, &template)
 	if err != nil {
@@ -999,7 +1062,7 @@ func SyncGuildTemplate(ctx context.Context, session *Session, guildID Snowflake,
 }
 
 // ModifyGuildTemplate modifies a guild template.
-func ModifyGuildTemplate(ctx context.Context, session *Session, guildID Snowflake, templateCode string, params any, reason *string) (any, error) {
+func ModifyGuildTemplate(ctx context.Context, session *Session, guildID Snowflake, templateCode string, params ModifyGuildTemplateParams, reason *string) (*GuildTemplate, error) {
 	endpoint := EndpointGuildTemplate(guildID.String(), templateCode)
 
 	headers := http.Header{}
@@ -1007,7 +1070,7 @@ func ModifyGuildTemplate(ctx context.Context, session *Session, guildID Snowflak
 		headers.Add(AuditLogReasonHeader, *reason)
 	}
 
-	var template any
+	var template *GuildTemplate
 
 	err := session.Interface.FetchJJ(ctx, session, http.MethodPatch, endpoint, params, headers, &template)
 	if err != nil {
@@ -0,0 +1,265 @@
+package discord
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// image.go contains helpers for encoding uploaded images as data URIs, as used when setting
+// avatars, banners, icons, emojis and stickers.
+
+// ImageUsage represents what an uploaded image will be used for, which decides the allowed
+// formats and maximum size.
+type ImageUsage uint8
+
+const (
+	ImageUsageAvatar ImageUsage = iota
+	ImageUsageBanner
+	ImageUsageIcon
+	ImageUsageEmoji
+	ImageUsageSticker
+)
+
+// Image upload limits.
+const (
+	MaxEmojiSize   = 256 * 1024
+	MaxStickerSize = 512 * 1024
+	MaxAvatarSize  = 10 * 1024 * 1024
+)
+
+// Image MIME types.
+const (
+	ImageMimeTypePNG    = "image/png"
+	ImageMimeTypeAPNG   = "image/apng"
+	ImageMimeTypeJPEG   = "image/jpeg"
+	ImageMimeTypeGIF    = "image/gif"
+	ImageMimeTypeWebP   = "image/webp"
+	ImageMimeTypeAVIF   = "image/avif"
+	ImageMimeTypeLottie = "application/json"
+)
+
+// imageSniffLength is how many bytes are read from a stream to detect its type. It is large
+// enough to reach the acTL chunk of an APNG, which must come before the image data.
+const imageSniffLength = 4096
+
+var (
+	pngSignature = []byte{137, 80, 78, 71, 13, 10, 26, 10}
+	jpegPrefix   = []byte{255, 216, 255}
+	gif87a       = []byte("GIF87a")
+	gif89a       = []byte("GIF89a")
+)
+
+// Limit returns the maximum size of an image in bytes.
+func (iu ImageUsage) Limit() int {
+	switch iu {
+	case ImageUsageEmoji:
+		return MaxEmojiSize
+	case ImageUsageSticker:
+		return MaxStickerSize
+	default:
+		return MaxAvatarSize
+	}
+}
+
+// MimeTypes returns the image types accepted for the usage.
+func (iu ImageUsage) MimeTypes() []string {
+	switch iu {
+	case ImageUsageSticker:
+		return []string{ImageMimeTypePNG, ImageMimeTypeAPNG, ImageMimeTypeGIF, ImageMimeTypeLottie}
+	default:
+		return []string{ImageMimeTypePNG, ImageMimeTypeAPNG, ImageMimeTypeJPEG, ImageMimeTypeGIF, ImageMimeTypeWebP, ImageMimeTypeAVIF}
+	}
+}
+
+func (iu ImageUsage) String() string {
+	switch iu {
+	case ImageUsageAvatar:
+		return "avatar"
+	case ImageUsageBanner:
+		return "banner"
+	case ImageUsageIcon:
+		return "icon"
+	case ImageUsageEmoji:
+		return "emoji"
+	case ImageUsageSticker:
+		return "sticker"
+	default:
+		return "image"
+	}
+}
+
+// DetectImageType returns the MIME type of an image from its leading bytes. Any JSON object is
+// reported as a Lottie animation, EncodeImage checks its contents once fully read.
+// Returns ErrUnsupportedImageType if the type is not recognised.
+func DetectImageType(data []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		if isAPNG(data) {
+			return ImageMimeTypeAPNG, nil
+		}
+
+		return ImageMimeTypePNG, nil
+	case bytes.HasPrefix(data, jpegPrefix):
+		return ImageMimeTypeJPEG, nil
+	case bytes.HasPrefix(data, gif87a), bytes.HasPrefix(data, gif89a):
+		return ImageMimeTypeGIF, nil
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return ImageMimeTypeWebP, nil
+	case isAVIF(data):
+		return ImageMimeTypeAVIF, nil
+	case bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("{")):
+		return ImageMimeTypeLottie, nil
+	default:
+		return "", ErrUnsupportedImageType
+	}
+}
+
+// isAPNG returns if a PNG has an animation control chunk before its image data.
+func isAPNG(data []byte) bool {
+	offset := len(pngSignature)
+
+	for offset+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[offset : offset+4]))
+		chunkType := string(data[offset+4 : offset+8])
+
+		switch chunkType {
+		case "acTL":
+			return true
+		case "IDAT", "IEND":
+			return false
+		}
+
+		// Skip the chunk header, data and CRC.
+		next := offset + 12 + length
+		if length < 0 || next <= offset {
+			return false
+		}
+
+		offset = next
+	}
+
+	return false
+}
+
+// isAVIF returns if data starts with an ISO BMFF ftyp box with an AVIF brand.
+func isAVIF(data []byte) bool {
+	if len(data) < 12 || !bytes.Equal(data[4:8], []byte("ftyp")) {
+		return false
+	}
+
+	boxSize := int(binary.BigEndian.Uint32(data[0:4]))
+	if boxSize < 16 || boxSize > len(data) {
+		boxSize = len(data)
+	}
+
+	// The major brand is followed by a minor version and a list of compatible brands.
+	brands := [][]byte{data[8:12]}
+	for offset := 16; offset+4 <= boxSize; offset += 4 {
+		brands = append(brands, data[offset:offset+4])
+	}
+
+	for _, brand := range brands {
+		if bytes.Equal(brand, []byte("avif")) || bytes.Equal(brand, []byte("avis")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isLottie returns if data is a Lottie animation, which is JSON with a version and layers.
+func isLottie(data []byte) bool {
+	var lottie struct {
+		Version string            `json:"v"`
+		Layers  []json.RawMessage `json:"layers"`
+	}
+
+	return json.Unmarshal(data, &lottie) == nil && lottie.Version != "" && lottie.Layers != nil
+}
+
+// checkImageType returns an error if the image type cannot be used for the usage.
+func checkImageType(mimeType string, usage ImageUsage) error {
+	if !slices.Contains(usage.MimeTypes(), mimeType) {
+		return fmt.Errorf("%w: %s cannot be used for %s", ErrUnsupportedImageType, mimeType, usage)
+	}
+
+	return nil
+}
+
+// EncodeImage encodes an image as a data URI after checking its type and size are allowed for the usage.
+func EncodeImage(data []byte, usage ImageUsage) (string, error) {
+	return EncodeImageReader(bytes.NewReader(data), usage)
+}
+
+// EncodeImageReader encodes an image from a reader as a data URI. The image is read until EOF,
+// and ErrImageTooLarge is returned as soon as it exceeds the limit for the usage.
+func EncodeImageReader(reader io.Reader, usage ImageUsage) (string, error) {
+	bufferedReader := bufio.NewReaderSize(reader, imageSniffLength)
+
+	header, err := bufferedReader.Peek(imageSniffLength)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return "", fmt.Errorf("failed to read image: %w", err)
+	}
+
+	mimeType, err := DetectImageType(header)
+	if err != nil {
+		return "", err
+	}
+
+	err = checkImageType(mimeType, usage)
+	if err != nil {
+		return "", err
+	}
+
+	// APNGs are valid PNGs and are uploaded as such.
+	uriMimeType := mimeType
+	if mimeType == ImageMimeTypeAPNG {
+		uriMimeType = ImageMimeTypePNG
+	}
+
+	limit := usage.Limit()
+	prefix := "data:" + uriMimeType + ";base64,"
+
+	var out strings.Builder
+
+	out.Grow(len(prefix) + base64.StdEncoding.EncodedLen(len(header)))
+	out.WriteString(prefix)
+
+	encoder := base64.NewEncoder(base64.StdEncoding, &out)
+
+	// Lottie animations are kept so they can be validated once fully read.
+	var lottie bytes.Buffer
+
+	var destination io.Writer = encoder
+	if mimeType == ImageMimeTypeLottie {
+		destination = io.MultiWriter(encoder, &lottie)
+	}
+
+	written, err := io.Copy(destination, io.LimitReader(bufferedReader, int64(limit)+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	if written > int64(limit) {
+		return "", fmt.Errorf("%w: %s must be at most %d bytes", ErrImageTooLarge, usage, limit)
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	if mimeType == ImageMimeTypeLottie && !isLottie(lottie.Bytes()) {
+		return "", fmt.Errorf("%w: json is not a lottie animation", ErrUnsupportedImageType)
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,304 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// interaction_responder.go contains a helper that tracks the response lifecycle of an
+// interaction and defers it automatically when a handler is slow to reply.
+
+const (
+	// InteractionAcknowledgeWindow is how long discord waits for an interaction to be acknowledged.
+	InteractionAcknowledgeWindow = 3 * time.Second
+
+	// InteractionTokenLifetime is how long an interaction token can be used after it is created.
+	InteractionTokenLifetime = 15 * time.Minute
+
+	// DefaultInteractionDeferBudget is how long a responder waits for a reply before deferring.
+	DefaultInteractionDeferBudget = 2 * time.Second
+
+	// interactionDeferMargin is how long before the acknowledge window closes the automatic
+	// defer is sent at the latest, leaving time for the request to reach discord.
+	interactionDeferMargin = 500 * time.Millisecond
+)
+
+// InteractionResponderState represents how far an interaction has been responded to.
+type InteractionResponderState uint8
+
+const (
+	// InteractionResponderStatePending means the interaction has not been acknowledged.
+	InteractionResponderStatePending InteractionResponderState = iota
+
+	// InteractionResponderStateDeferred means the interaction was deferred and the original
+	// response has not been sent yet.
+	InteractionResponderStateDeferred
+
+	// InteractionResponderStateResponded means the original response has been sent.
+	InteractionResponderStateResponded
+)
+
+// InteractionResponder sends the responses to an interaction. If the handler does not reply
+// within the defer budget, the interaction is deferred with DeferredChannelMessageSource, or
+// DeferredUpdateMessage for message components. Replies are then routed to the original
+// response or a followup depending on what has already been sent.
+type InteractionResponder struct {
+	Session     *Session
+	Interaction *Interaction
+
+	// ReceivedAt is when the interaction was created, taken from its ID. Token expiry is
+	// measured from here.
+	ReceivedAt time.Time
+
+	// DeferErrorHandler is called if the automatic defer fails. Optional.
+	DeferErrorHandler func(err error)
+
+	timer *time.Timer
+
+	// Ephemeral makes automatic deferrals of commands ephemeral, so that the eventual reply is
+	// only visible to the user.
+	Ephemeral bool
+
+	state InteractionResponderState
+
+	// deferType is the callback type sent when the interaction was deferred.
+	deferType InteractionCallbackType
+
+	mu sync.Mutex
+}
+
+// NewInteractionResponder creates a responder for an interaction and starts the automatic defer timer.
+// budget: How long after the interaction was created to wait for a reply before deferring. If zero,
+// DefaultInteractionDeferBudget is used. The defer is always sent before the acknowledge window
+// closes. A negative budget disables automatic deferral.
+func NewInteractionResponder(session *Session, interaction *Interaction, budget time.Duration) *InteractionResponder {
+	responder := &InteractionResponder{
+		Session:     session,
+		Interaction: interaction,
+		ReceivedAt:  interaction.ID.Time(),
+	}
+
+	if interaction.ID.IsNil() {
+		responder.ReceivedAt = time.Now()
+	}
+
+	if budget == 0 {
+		budget = DefaultInteractionDeferBudget
+	}
+
+	if budget > 0 {
+		budget = min(budget, InteractionAcknowledgeWindow-interactionDeferMargin)
+		delay := max(budget-time.Since(responder.ReceivedAt), 0)
+
+		responder.timer = time.AfterFunc(delay, responder.autoDefer)
+	}
+
+	return responder
+}
+
+// autoDefer defers the interaction, giving up once the acknowledge window closes so a slow
+// request does not hold the lock for longer.
+func (ir *InteractionResponder) autoDefer() {
+	ctx, cancel := context.WithDeadline(context.Background(), ir.ReceivedAt.Add(InteractionAcknowledgeWindow))
+	defer cancel()
+
+	err := ir.Defer(ctx)
+	if err != nil && ir.DeferErrorHandler != nil {
+		ir.DeferErrorHandler(err)
+	}
+}
+
+// State returns the current response state of the interaction.
+func (ir *InteractionResponder) State() InteractionResponderState {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	return ir.state
+}
+
+// Expired returns if the interaction token can no longer be used.
+func (ir *InteractionResponder) Expired() bool {
+	return time.Since(ir.ReceivedAt) >= InteractionTokenLifetime
+}
+
+// Stop stops the automatic defer timer. This does not stop a defer that is already in progress.
+func (ir *InteractionResponder) Stop() {
+	if ir.timer != nil {
+		ir.timer.Stop()
+	}
+}
+
+// checkLocked returns an error if the interaction can no longer be responded to in its current state.
+func (ir *InteractionResponder) checkLocked() error {
+	elapsed := time.Since(ir.ReceivedAt)
+
+	if elapsed >= InteractionTokenLifetime {
+		return fmt.Errorf("%w: received %s ago", ErrInteractionExpired, elapsed.Round(time.Second))
+	}
+
+	if ir.state == InteractionResponderStatePending && elapsed >= InteractionAcknowledgeWindow {
+		return fmt.Errorf("%w: received %s ago", ErrAcknowledgeExpired, elapsed.Round(time.Millisecond))
+	}
+
+	return nil
+}
+
+// Defer acknowledges the interaction without sending a response. Deferring an interaction that
+// has already been acknowledged does nothing.
+func (ir *InteractionResponder) Defer(ctx context.Context) error {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	if ir.state != InteractionResponderStatePending {
+		return nil
+	}
+
+	err := ir.checkLocked()
+	if err != nil {
+		return err
+	}
+
+	response := InteractionResponse{
+		Type: InteractionCallbackTypeDeferredChannelMessageSource,
+		Data: &InteractionCallbackData{},
+	}
+
+	if ir.Interaction.Type == InteractionTypeMessageComponent {
+		response.Type = InteractionCallbackTypeDeferredUpdateMessage
+	} else if ir.Ephemeral {
+		response.Data.Flags = uint32(MessageFlagEphemeral)
+	}
+
+	err = CreateInteractionResponse(ctx, ir.Session, ir.Interaction.ID, ir.Interaction.Token, response)
+	if err != nil {
+		return err
+	}
+
+	ir.state = InteractionResponderStateDeferred
+	ir.deferType = response.Type
+
+	return nil
+}
+
+// Reply sends a message in response to the interaction. If the interaction has not been
+// acknowledged, this sends the initial response. If it was deferred with
+// DeferredChannelMessageSource, the original response is edited. Otherwise a followup message is
+// sent, so the message a component is attached to is never replaced.
+func (ir *InteractionResponder) Reply(ctx context.Context, data InteractionCallbackData) error {
+	return ir.respond(ctx, InteractionCallbackTypeChannelMessageSource, data)
+}
+
+// Update edits the message a component is attached to. If the interaction was deferred or
+// already responded to, the original response is edited instead. Interactions without a message,
+// such as commands, cannot update a message, so the initial response is sent as a reply.
+func (ir *InteractionResponder) Update(ctx context.Context, data InteractionCallbackData) error {
+	return ir.respond(ctx, InteractionCallbackTypeUpdateMessage, data)
+}
+
+// hasMessage returns if the interaction was triggered from a message, so can update it. This is
+// true for message components and modals opened from one.
+func (ir *InteractionResponder) hasMessage() bool {
+	return ir.Interaction.Type == InteractionTypeMessageComponent ||
+		(ir.Interaction.Type == InteractionTypeModalSubmit && ir.Interaction.Message != nil)
+}
+
+func (ir *InteractionResponder) respond(ctx context.Context, callbackType InteractionCallbackType, data InteractionCallbackData) error {
+	ir.Stop()
+
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	err := ir.checkLocked()
+	if err != nil {
+		return err
+	}
+
+	switch ir.state {
+	case InteractionResponderStatePending:
+		if callbackType == InteractionCallbackTypeUpdateMessage && !ir.hasMessage() {
+			callbackType = InteractionCallbackTypeChannelMessageSource
+		}
+
+		err = CreateInteractionResponse(ctx, ir.Session, ir.Interaction.ID, ir.Interaction.Token, InteractionResponse{
+			Type: callbackType,
+			Data: &data,
+		})
+	case InteractionResponderStateDeferred, InteractionResponderStateResponded:
+		// After a DeferredUpdateMessage or a response, the original response is the message
+		// being updated, so only Update edits it.
+		editOriginal := callbackType == InteractionCallbackTypeUpdateMessage ||
+			(ir.state == InteractionResponderStateDeferred && ir.deferType != InteractionCallbackTypeDeferredUpdateMessage)
+
+		if editOriginal {
+			_, err = EditOriginalInteractionResponse(ctx, ir.Session, ir.Interaction.ApplicationID, ir.Interaction.Token, data.WebhookMessageParams())
+		} else {
+			_, err = CreateFollowupMessage(ctx, ir.Session, ir.Interaction.ApplicationID, ir.Interaction.Token, data.WebhookMessageParams())
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ir.state = InteractionResponderStateResponded
+
+	return nil
+}
+
+// EditOriginal edits the original response. The interaction must have been acknowledged.
+func (ir *InteractionResponder) EditOriginal(ctx context.Context, messageParams WebhookMessageParams) (*Message, error) {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	err := ir.checkAcknowledgedLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := EditOriginalInteractionResponse(ctx, ir.Session, ir.Interaction.ApplicationID, ir.Interaction.Token, messageParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ir.state = InteractionResponderStateResponded
+
+	return message, nil
+}
+
+// Followup sends a followup message. The interaction must have been acknowledged.
+func (ir *InteractionResponder) Followup(ctx context.Context, messageParams WebhookMessageParams) (*Message, error) {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	err := ir.checkAcknowledgedLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	return CreateFollowupMessage(ctx, ir.Session, ir.Interaction.ApplicationID, ir.Interaction.Token, messageParams)
+}
+
+func (ir *InteractionResponder) checkAcknowledgedLocked() error {
+	if ir.state == InteractionResponderStatePending {
+		return ErrNotAcknowledged
+	}
+
+	return ir.checkLocked()
+}
+
+// WebhookMessageParams converts interaction callback data to the parameters used to edit the
+// original response or send a followup.
+func (icd InteractionCallbackData) WebhookMessageParams() WebhookMessageParams {
+	return WebhookMessageParams{
+		Content:         icd.Content,
+		Embeds:          icd.Embeds,
+		AllowedMentions: icd.AllowedMentions,
+		Components:      icd.Components,
+		Files:           icd.Files,
+		Attachments:     icd.Attachments,
+		Flags:           MessageFlags(icd.Flags),
+		TTS:             icd.TTS,
+	}
+}
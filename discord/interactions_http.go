@@ -9,7 +9,7 @@ import (
 func CreateInteractionResponse(ctx context.Context, session *Session, interactionID Snowflake, interactionToken string, interactionResponse InteractionResponse) error {
 	endpoint := EndpointInteractionResponse(interactionID.String(), interactionToken)
 
-	if len(interactionResponse.Data.Files) > 0 {
+	if interactionResponse.Data != nil && len(interactionResponse.Data.Files) > 0 {
 		contentType, body, err := multipartBodyWithJSON(interactionResponse, interactionResponse.Data.Files)
 		if err != nil {
 			return err
@@ -0,0 +1,238 @@
+package discord
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// interactions_server.go contains an http.Handler for receiving interactions through an
+// interactions endpoint URL instead of the gateway.
+
+const (
+	HeaderSignatureEd25519   = "X-Signature-Ed25519"
+	HeaderSignatureTimestamp = "X-Signature-Timestamp"
+
+	// DefaultInteractionTimestampWindow is the maximum age of a signed request that will be accepted.
+	DefaultInteractionTimestampWindow = 5 * time.Second
+
+	// DefaultInteractionMaxBodySize is the maximum request body size that will be read.
+	DefaultInteractionMaxBodySize = 4 << 20
+)
+
+// InteractionHandler handles an interaction received over HTTP. The returned response is
+// written inline as the HTTP response, so no CreateInteractionResponse call is required.
+// The response may be nil if the handler has already responded to the interaction itself, in
+// which case the request is answered with 202 Accepted and no body.
+type InteractionHandler func(ctx context.Context, interaction *Interaction) (*InteractionResponse, error)
+
+// InteractionServer is an http.Handler that verifies and dispatches interactions
+// sent to an application's interactions endpoint URL.
+type InteractionServer struct {
+	Handler InteractionHandler
+
+	// ErrorHandler is called when a request is rejected or the handler fails. Optional.
+	ErrorHandler func(r *http.Request, err error)
+
+	PublicKey ed25519.PublicKey
+
+	// TimestampWindow is the maximum difference between the signed timestamp and now.
+	TimestampWindow time.Duration
+	MaxBodySize     int64
+}
+
+// NewInteractionServer creates an interaction server.
+// publicKey: hex encoded public key of the application, see Application.VerifyKey.
+// handler: handler that is called for all interactions that are not pings.
+func NewInteractionServer(publicKey string, handler InteractionHandler) (*InteractionServer, error) {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key length: %d", len(key))
+	}
+
+	return &InteractionServer{
+		Handler:         handler,
+		PublicKey:       ed25519.PublicKey(key),
+		TimestampWindow: DefaultInteractionTimestampWindow,
+		MaxBodySize:     DefaultInteractionMaxBodySize,
+	}, nil
+}
+
+// VerifyInteraction verifies the signature of an interaction request body.
+// Requests with a timestamp further than window from the current time are rejected
+// to prevent replays. A window of 0 disables the timestamp check.
+func VerifyInteraction(publicKey ed25519.PublicKey, signature, timestamp string, body []byte, window time.Duration) error {
+	if signature == "" || timestamp == "" {
+		return ErrInvalidSignature
+	}
+
+	decodedSignature, err := hex.DecodeString(signature)
+	if err != nil || len(decodedSignature) != ed25519.SignatureSize {
+		return ErrInvalidSignature
+	}
+
+	if window > 0 {
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrInvalidSignature
+		}
+
+		difference := time.Since(time.Unix(unix, 0))
+		if difference < 0 {
+			difference = -difference
+		}
+
+		if difference > window {
+			return ErrStaleTimestamp
+		}
+	}
+
+	message := make([]byte, 0, len(timestamp)+len(body))
+	message = append(message, timestamp...)
+	message = append(message, body...)
+
+	if !ed25519.Verify(publicKey, message, decodedSignature) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (is *InteractionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	maxBodySize := is.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultInteractionMaxBodySize
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		is.handleError(w, r, http.StatusBadRequest, fmt.Errorf("failed to read body: %w", err))
+
+		return
+	}
+
+	if int64(len(body)) > maxBodySize {
+		is.handleError(w, r, http.StatusRequestEntityTooLarge, ErrRequestTooLarge)
+
+		return
+	}
+
+	err = VerifyInteraction(
+		is.PublicKey,
+		r.Header.Get(HeaderSignatureEd25519),
+		r.Header.Get(HeaderSignatureTimestamp),
+		body,
+		is.TimestampWindow,
+	)
+	if err != nil {
+		is.handleError(w, r, http.StatusUnauthorized, err)
+
+		return
+	}
+
+	var interaction *Interaction
+
+	err = json.Unmarshal(body, &interaction)
+	if err != nil {
+		is.handleError(w, r, http.StatusBadRequest, fmt.Errorf("failed to unmarshal interaction: %w", err))
+
+		return
+	}
+
+	if interaction == nil {
+		is.handleError(w, r, http.StatusBadRequest, ErrInvalidInteraction)
+
+		return
+	}
+
+	var response *InteractionResponse
+
+	if interaction.Type == InteractionTypePing {
+		response = &InteractionResponse{
+			Type: InteractionCallbackTypePong,
+		}
+	} else {
+		if is.Handler == nil {
+			is.handleError(w, r, http.StatusInternalServerError, ErrNoInteractionHandler)
+
+			return
+		}
+
+		response, err = is.Handler(r.Context(), interaction)
+		if err != nil {
+			is.handleError(w, r, http.StatusInternalServerError, err)
+
+			return
+		}
+
+		if response == nil {
+			w.WriteHeader(http.StatusAccepted)
+
+			return
+		}
+	}
+
+	err = WriteInteractionResponse(w, *response)
+	if err != nil && is.ErrorHandler != nil {
+		is.ErrorHandler(r, err)
+	}
+}
+
+func (is *InteractionServer) handleError(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	if is.ErrorHandler != nil {
+		is.ErrorHandler(r, err)
+	}
+
+	http.Error(w, http.StatusText(statusCode), statusCode)
+}
+
+// WriteInteractionResponse writes an interaction response to an HTTP response. If the
+// response data contains files, the response is sent as multipart/form-data.
+func WriteInteractionResponse(w http.ResponseWriter, interactionResponse InteractionResponse) error {
+	var contentType string
+
+	var body []byte
+
+	var err error
+
+	if interactionResponse.Data != nil && len(interactionResponse.Data.Files) > 0 {
+		contentType, body, err = multipartBodyWithJSON(interactionResponse, interactionResponse.Data.Files)
+		if err != nil {
+			return fmt.Errorf("failed to create multipart body: %w", err)
+		}
+	} else {
+		contentType = "application/json"
+
+		body, err = json.Marshal(interactionResponse)
+		if err != nil {
+			return fmt.Errorf("failed to marshal interaction response: %w", err)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(body)
+	if err != nil {
+		return fmt.Errorf("failed to write interaction response: %w", err)
+	}
+
+	return nil
+}
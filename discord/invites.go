@@ -85,22 +85,23 @@ type InviteStageInstance struct {
 
 // ScheduledEvent represents an scheduled event.
 type ScheduledEvent struct {
-	Status             EventStatus              `json:"status"`
-	EntityType         ScheduledEntityType      `json:"entity_type"`
-	ChannelID          *Snowflake               `json:"channel_id,omitempty"`
-	CreatorID          *Snowflake               `json:"creator_id,omitempty"`
-	Creator            *User                    `json:"creator,omitempty"`
-	EntityMetadata     *EventMetadata           `json:"entity_metadata,omitempty"`
-	EntityID           *Snowflake               `json:"entity_id,omitempty"`
-	Image              *string                  `json:"image,omitempty"`
-	PrivacyLevel       StageChannelPrivacyLevel `json:"privacy_level"`
-	ScheduledStartTime string                   `json:"scheduled_start_time"`
-	ScheduledEndTime   string                   `json:"scheduled_end_time,omitempty"`
-	Description        string                   `json:"description,omitempty"`
-	Name               string                   `json:"name"`
-	ID                 Snowflake                `json:"id"`
-	GuildID            Snowflake                `json:"guild_id"`
-	UserCount          int32                    `json:"user_count,omitempty"`
+	ScheduledStartTime time.Time                     `json:"scheduled_start_time"`
+	ScheduledEndTime   *time.Time                    `json:"scheduled_end_time,omitempty"`
+	ChannelID          *Snowflake                    `json:"channel_id,omitempty"`
+	CreatorID          *Snowflake                    `json:"creator_id,omitempty"`
+	Creator            *User                         `json:"creator,omitempty"`
+	EntityMetadata     *EventMetadata                `json:"entity_metadata,omitempty"`
+	EntityID           *Snowflake                    `json:"entity_id,omitempty"`
+	Image              *string                       `json:"image,omitempty"`
+	RecurrenceRule     *ScheduledEventRecurrenceRule `json:"recurrence_rule,omitempty"`
+	Description        string                        `json:"description,omitempty"`
+	Name               string                        `json:"name"`
+	ID                 Snowflake                     `json:"id"`
+	GuildID            Snowflake                     `json:"guild_id"`
+	UserCount          int32                         `json:"user_count,omitempty"`
+	Status             EventStatus                   `json:"status"`
+	EntityType         ScheduledEntityType           `json:"entity_type"`
+	PrivacyLevel       StageChannelPrivacyLevel      `json:"privacy_level"`
 }
 
 // EventMetadata contains extra information about a scheduled event.
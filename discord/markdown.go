@@ -0,0 +1,369 @@
+package discord
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// markdown.go contains helpers for formatting message content with discord's markdown,
+// mentions and timestamps, and for escaping user supplied text.
+
+// TimestampStyle represents the display style of a timestamp.
+type TimestampStyle rune
+
+const (
+	// TimestampStyleShortTime displays as 16:20.
+	TimestampStyleShortTime TimestampStyle = 't'
+
+	// TimestampStyleLongTime displays as 16:20:30.
+	TimestampStyleLongTime TimestampStyle = 'T'
+
+	// TimestampStyleShortDate displays as 20/04/2021.
+	TimestampStyleShortDate TimestampStyle = 'd'
+
+	// TimestampStyleLongDate displays as 20 April 2021.
+	TimestampStyleLongDate TimestampStyle = 'D'
+
+	// TimestampStyleShortDateTime displays as 20 April 2021 16:20. This is the default style.
+	TimestampStyleShortDateTime TimestampStyle = 'f'
+
+	// TimestampStyleLongDateTime displays as Tuesday, 20 April 2021 16:20.
+	TimestampStyleLongDateTime TimestampStyle = 'F'
+
+	// TimestampStyleRelative displays as 2 months ago.
+	TimestampStyleRelative TimestampStyle = 'R'
+)
+
+// zeroWidthSpace is inserted into text to stop discord from parsing it as markdown or a mention.
+const zeroWidthSpace = "\u200b"
+
+// MentionUser returns a mention of a user.
+func MentionUser(userID Snowflake) string {
+	return "<@" + userID.String() + ">"
+}
+
+// MentionRole returns a mention of a role.
+func MentionRole(roleID Snowflake) string {
+	return "<@&" + roleID.String() + ">"
+}
+
+// MentionChannel returns a mention of a channel.
+func MentionChannel(channelID Snowflake) string {
+	return "<#" + channelID.String() + ">"
+}
+
+// MentionCommand returns a clickable mention of a slash command.
+// name: The full name of the command, including subcommands, such as "settings welcome channel".
+// commandID: The ID of the top level command.
+func MentionCommand(name string, commandID Snowflake) string {
+	return "</" + normalizeCommandPath(name) + ":" + commandID.String() + ">"
+}
+
+// FormatEmoji returns the markdown for an emoji. Custom emojis are formatted as <:name:id>,
+// or <a:name:id> if animated. Unicode emojis are returned as is.
+func FormatEmoji(emoji Emoji) string {
+	if emoji.ID.IsNil() {
+		return emoji.Name
+	}
+
+	if emoji.Animated {
+		return "<a:" + emoji.Name + ":" + emoji.ID.String() + ">"
+	}
+
+	return "<:" + emoji.Name + ":" + emoji.ID.String() + ">"
+}
+
+// FormatTimestamp returns a timestamp that is displayed in the user's timezone. If style is
+// zero, Discord's default style is used.
+func FormatTimestamp(t time.Time, style TimestampStyle) string {
+	if style == 0 {
+		return "<t:" + strconv.FormatInt(t.Unix(), 10) + ">"
+	}
+
+	return "<t:" + strconv.FormatInt(t.Unix(), 10) + ":" + string(style) + ">"
+}
+
+// Mention returns a mention of the user.
+func (u *User) Mention() string {
+	return MentionUser(u.ID)
+}
+
+// Mention returns a mention of the role.
+func (r *Role) Mention() string {
+	return MentionRole(r.ID)
+}
+
+// Mention returns a mention of the channel.
+func (c *Channel) Mention() string {
+	return MentionChannel(c.ID)
+}
+
+// Mention returns the markdown for the emoji.
+func (e *Emoji) Mention() string {
+	return FormatEmoji(*e)
+}
+
+// Bold returns text in bold.
+func Bold(text string) string {
+	return "**" + text + "**"
+}
+
+// Italic returns text in italics.
+func Italic(text string) string {
+	return "*" + text + "*"
+}
+
+// Underline returns underlined text.
+func Underline(text string) string {
+	return "__" + text + "__"
+}
+
+// Strikethrough returns text with a strikethrough.
+func Strikethrough(text string) string {
+	return "~~" + text + "~~"
+}
+
+// Spoiler returns text hidden behind a spoiler.
+func Spoiler(text string) string {
+	return "||" + text + "||"
+}
+
+// InlineCode returns text as inline code. Text containing backticks is wrapped in double backticks.
+func InlineCode(text string) string {
+	if !strings.Contains(text, "`") {
+		return "`" + text + "`"
+	}
+
+	text = strings.ReplaceAll(text, "``", "`"+zeroWidthSpace+"`")
+
+	return "`` " + text + " ``"
+}
+
+// CodeBlock returns text as a code block with optional syntax highlighting. Any closing fences
+// in the code are broken up so the code block cannot be escaped.
+func CodeBlock(language, code string) string {
+	code = strings.ReplaceAll(code, "```", "``"+zeroWidthSpace+"`")
+
+	return "```" + language + "\n" + code + "\n```"
+}
+
+// Header returns a header. Level is clamped between 1 and 3.
+func Header(level int, text string) string {
+	level = max(1, min(level, 3))
+
+	return strings.Repeat("#", level) + " " + text
+}
+
+// Subtext returns small, muted text.
+func Subtext(text string) string {
+	return "-# " + text
+}
+
+// MaskedLink returns a link displayed as text. Brackets in the text are escaped.
+func MaskedLink(text, url string) string {
+	text = strings.NewReplacer("[", "\\[", "]", "\\]").Replace(text)
+
+	return "[" + text + "](" + url + ")"
+}
+
+// Quote returns text as a block quote. Each line of the text is quoted.
+func Quote(text string) string {
+	return "> " + strings.ReplaceAll(text, "\n", "\n> ")
+}
+
+// BlockQuote returns a block quote containing the rest of the message.
+func BlockQuote(text string) string {
+	return ">>> " + text
+}
+
+// markdownReplacer escapes characters that discord treats as markdown anywhere in a line.
+var markdownReplacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"*", "\\*",
+	"_", "\\_",
+	"~", "\\~",
+	"`", "\\`",
+	"|", "\\|",
+	"[", "\\[",
+	"]", "\\]",
+)
+
+// markupOpenRegex matches the start of a mention, emoji, timestamp or command mention.
+var markupOpenRegex = regexp.MustCompile(`<(@|#|:|/|a:|t:|id:)`)
+
+// lineMarkdownRegex matches headings, subtext, lists and block quotes at the start of a line.
+var lineMarkdownRegex = regexp.MustCompile(`(?m)^[ \t]*([#>-]|\d+\.[ \t])`)
+
+// EscapeMarkdown escapes markdown in text so that it is displayed as written.
+// This should be used for user supplied text such as usernames. Characters that only have a
+// meaning at the start of a line, such as # and -, are only escaped there.
+func EscapeMarkdown(text string) string {
+	text = markdownReplacer.Replace(text)
+	text = markupOpenRegex.ReplaceAllString(text, `\<$1`)
+
+	return lineMarkdownRegex.ReplaceAllStringFunc(text, func(match string) string {
+		if dot := strings.LastIndexByte(match, '.'); dot != -1 {
+			return match[:dot] + `\` + match[dot:]
+		}
+
+		trimmed := strings.TrimLeft(match, " \t")
+
+		return match[:len(match)-len(trimmed)] + `\` + trimmed
+	})
+}
+
+// mentionReplacer breaks up mentions by inserting a zero width space after the @.
+var mentionReplacer = strings.NewReplacer(
+	"@", "@"+zeroWidthSpace,
+)
+
+// EscapeMentions stops text from mentioning @everyone, @here, users or roles.
+func EscapeMentions(text string) string {
+	return mentionReplacer.Replace(text)
+}
+
+// EscapeContent escapes both markdown and mentions in text.
+func EscapeContent(text string) string {
+	return EscapeMentions(EscapeMarkdown(text))
+}
+
+// ContentBuilder builds message content. Text written with Text is escaped, all other
+// methods write markdown as is.
+type ContentBuilder struct {
+	builder strings.Builder
+}
+
+// NewContentBuilder creates an empty content builder.
+func NewContentBuilder() *ContentBuilder {
+	return &ContentBuilder{}
+}
+
+// Write writes raw content without escaping it.
+func (cb *ContentBuilder) Write(content string) *ContentBuilder {
+	cb.builder.WriteString(content)
+
+	return cb
+}
+
+// Text writes user supplied text with markdown and mentions escaped.
+func (cb *ContentBuilder) Text(text string) *ContentBuilder {
+	return cb.Write(EscapeContent(text))
+}
+
+// Line writes content followed by a newline.
+func (cb *ContentBuilder) Line(content string) *ContentBuilder {
+	return cb.Write(content).Newline()
+}
+
+// Newline writes a newline.
+func (cb *ContentBuilder) Newline() *ContentBuilder {
+	return cb.Write("\n")
+}
+
+// Bold writes text in bold.
+func (cb *ContentBuilder) Bold(text string) *ContentBuilder {
+	return cb.Write(Bold(text))
+}
+
+// Italic writes text in italics.
+func (cb *ContentBuilder) Italic(text string) *ContentBuilder {
+	return cb.Write(Italic(text))
+}
+
+// Underline writes underlined text.
+func (cb *ContentBuilder) Underline(text string) *ContentBuilder {
+	return cb.Write(Underline(text))
+}
+
+// Strikethrough writes text with a strikethrough.
+func (cb *ContentBuilder) Strikethrough(text string) *ContentBuilder {
+	return cb.Write(Strikethrough(text))
+}
+
+// Spoiler writes text hidden behind a spoiler.
+func (cb *ContentBuilder) Spoiler(text string) *ContentBuilder {
+	return cb.Write(Spoiler(text))
+}
+
+// InlineCode writes text as inline code.
+func (cb *ContentBuilder) InlineCode(text string) *ContentBuilder {
+	return cb.Write(InlineCode(text))
+}
+
+// CodeBlock writes a code block on its own lines.
+func (cb *ContentBuilder) CodeBlock(language, code string) *ContentBuilder {
+	return cb.startLine().Line(CodeBlock(language, code))
+}
+
+// Header writes a header on its own line.
+func (cb *ContentBuilder) Header(level int, text string) *ContentBuilder {
+	return cb.startLine().Line(Header(level, text))
+}
+
+// Subtext writes subtext on its own line.
+func (cb *ContentBuilder) Subtext(text string) *ContentBuilder {
+	return cb.startLine().Line(Subtext(text))
+}
+
+// Quote writes a block quote on its own lines.
+func (cb *ContentBuilder) Quote(text string) *ContentBuilder {
+	return cb.startLine().Line(Quote(text))
+}
+
+// MaskedLink writes a link displayed as text.
+func (cb *ContentBuilder) MaskedLink(text, url string) *ContentBuilder {
+	return cb.Write(MaskedLink(text, url))
+}
+
+// User writes a mention of a user.
+func (cb *ContentBuilder) User(userID Snowflake) *ContentBuilder {
+	return cb.Write(MentionUser(userID))
+}
+
+// Role writes a mention of a role.
+func (cb *ContentBuilder) Role(roleID Snowflake) *ContentBuilder {
+	return cb.Write(MentionRole(roleID))
+}
+
+// Channel writes a mention of a channel.
+func (cb *ContentBuilder) Channel(channelID Snowflake) *ContentBuilder {
+	return cb.Write(MentionChannel(channelID))
+}
+
+// Command writes a mention of a slash command.
+func (cb *ContentBuilder) Command(name string, commandID Snowflake) *ContentBuilder {
+	return cb.Write(MentionCommand(name, commandID))
+}
+
+// Emoji writes an emoji.
+func (cb *ContentBuilder) Emoji(emoji Emoji) *ContentBuilder {
+	return cb.Write(FormatEmoji(emoji))
+}
+
+// Timestamp writes a timestamp.
+func (cb *ContentBuilder) Timestamp(t time.Time, style TimestampStyle) *ContentBuilder {
+	return cb.Write(FormatTimestamp(t, style))
+}
+
+// startLine writes a newline if the content does not already end with one, as some
+// markdown is only parsed at the start of a line.
+func (cb *ContentBuilder) startLine() *ContentBuilder {
+	if cb.builder.Len() > 0 && !strings.HasSuffix(cb.builder.String(), "\n") {
+		cb.Newline()
+	}
+
+	return cb
+}
+
+// Len returns the length of the content in characters.
+func (cb *ContentBuilder) Len() int {
+	return utf8.RuneCountInString(cb.builder.String())
+}
+
+// String returns the content.
+func (cb *ContentBuilder) String() string {
+	return cb.builder.String()
+}
@@ -0,0 +1,241 @@
+package discord
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// markup.go contains a parser that extracts mentions, emojis, timestamps and message links
+// from message content.
+
+// MarkupTokenType represents the type of a token found in message content.
+type MarkupTokenType uint8
+
+const (
+	MarkupTokenTypeUserMention MarkupTokenType = 1 + iota
+	MarkupTokenTypeRoleMention
+	MarkupTokenTypeChannelMention
+	MarkupTokenTypeCommandMention
+	MarkupTokenTypeEveryoneMention
+	MarkupTokenTypeHereMention
+	MarkupTokenTypeEmoji
+	MarkupTokenTypeTimestamp
+	MarkupTokenTypeMessageLink
+)
+
+// MarkupToken represents a reference found in message content. Start and End are the byte
+// offsets of Raw within the content. Only the fields relevant to the token type are set.
+type MarkupToken struct {
+	// Timestamp is set for timestamps.
+	Timestamp time.Time
+
+	// Raw is the text of the token as it appears in the content.
+	Raw string
+
+	// Name is the emoji name or the full command name, such as "settings welcome channel".
+	Name string
+
+	Start int
+	End   int
+
+	// ID is the ID of the user, role, channel, command or emoji.
+	ID Snowflake
+
+	// GuildID, ChannelID and MessageID are set for message links. GuildID is zero for links to direct messages.
+	GuildID   Snowflake
+	ChannelID Snowflake
+	MessageID Snowflake
+
+	Type MarkupTokenType
+
+	// TimestampStyle is the style of a timestamp. Defaults to TimestampStyleShortDateTime when omitted.
+	TimestampStyle TimestampStyle
+
+	// Animated is set for animated emojis.
+	Animated bool
+}
+
+var markupRegex = regexp.MustCompile(
+	`<@!?(?P<user>\d+)>` +
+		`|<@&(?P<role>\d+)>` +
+		`|<#(?P<channel>\d+)>` +
+		`|</(?P<command>[-_\p{L}\p{N}]{1,32}(?: [-_\p{L}\p{N}]{1,32}){0,2}):(?P<commandID>\d+)>` +
+		`|<(?P<animated>a?):(?P<emoji>\w{2,32}):(?P<emojiID>\d+)>` +
+		`|<t:(?P<timestamp>-?\d{1,13})(?::(?P<style>[tTdDfFR]))?>` +
+		`|https?://(?:(?:ptb|canary)\.)?discord(?:app)?\.com/channels/(?P<linkGuild>\d+|@me)/(?P<linkChannel>\d+)/(?P<linkMessage>\d+)` +
+		`|@(?P<everyone>everyone|here)`,
+)
+
+// ParseMarkup returns the mentions, emojis, timestamps and message links in content, in the
+// order they appear. Mentions inside code blocks are not excluded.
+func ParseMarkup(content string) []MarkupToken {
+	matches := markupRegex.FindAllStringSubmatchIndex(content, -1)
+	tokens := make([]MarkupToken, 0, len(matches))
+
+	for _, match := range matches {
+		group := func(name string) (string, bool) {
+			i := markupRegex.SubexpIndex(name)
+			if match[2*i] < 0 {
+				return "", false
+			}
+
+			return content[match[2*i]:match[2*i+1]], true
+		}
+
+		token := MarkupToken{
+			Raw:   content[match[0]:match[1]],
+			Start: match[0],
+			End:   match[1],
+		}
+
+		var err error
+
+		matched := true
+
+		if id, found := group("user"); found {
+			token.Type = MarkupTokenTypeUserMention
+			token.ID, err = parseSnowflake(id)
+		} else if id, found := group("role"); found {
+			token.Type = MarkupTokenTypeRoleMention
+			token.ID, err = parseSnowflake(id)
+		} else if id, found := group("channel"); found {
+			token.Type = MarkupTokenTypeChannelMention
+			token.ID, err = parseSnowflake(id)
+		} else if name, found := group("command"); found {
+			token.Type = MarkupTokenTypeCommandMention
+			token.Name = name
+			id, _ := group("commandID")
+			token.ID, err = parseSnowflake(id)
+		} else if name, found := group("emoji"); found {
+			animated, _ := group("animated")
+			id, _ := group("emojiID")
+
+			token.Type = MarkupTokenTypeEmoji
+			token.Name = name
+			token.Animated = animated == "a"
+			token.ID, err = parseSnowflake(id)
+		} else if unix, found := group("timestamp"); found {
+			token.Type = MarkupTokenTypeTimestamp
+			token.TimestampStyle = TimestampStyleShortDateTime
+
+			if style, found := group("style"); found {
+				token.TimestampStyle = TimestampStyle(style[0])
+			}
+
+			var seconds int64
+
+			seconds, err = strconv.ParseInt(unix, 10, 64)
+			token.Timestamp = time.Unix(seconds, 0)
+		} else if guildID, found := group("linkGuild"); found {
+			channelID, _ := group("linkChannel")
+			messageID, _ := group("linkMessage")
+
+			token.Type = MarkupTokenTypeMessageLink
+
+			token.ChannelID, err = parseSnowflake(channelID)
+
+			if err == nil {
+				token.MessageID, err = parseSnowflake(messageID)
+			}
+
+			if err == nil && guildID != "@me" {
+				token.GuildID, err = parseSnowflake(guildID)
+			}
+		} else if name, found := group("everyone"); found {
+			token.Type = MarkupTokenTypeEveryoneMention
+			if name == "here" {
+				token.Type = MarkupTokenTypeHereMention
+			}
+		} else {
+			matched = false
+		}
+
+		if matched && err == nil {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
+
+// ContentMentions represents the users and roles mentioned in message content.
+type ContentMentions struct {
+	Users []Snowflake
+	Roles []Snowflake
+
+	// Everyone is set if the content mentions @everyone or @here.
+	Everyone bool
+}
+
+// ParseMentions returns the unique users and roles mentioned in content.
+func ParseMentions(content string) ContentMentions {
+	mentions := ContentMentions{}
+
+	for _, token := range ParseMarkup(content) {
+		switch token.Type {
+		case MarkupTokenTypeUserMention:
+			if !slices.Contains(mentions.Users, token.ID) {
+				mentions.Users = append(mentions.Users, token.ID)
+			}
+		case MarkupTokenTypeRoleMention:
+			if !slices.Contains(mentions.Roles, token.ID) {
+				mentions.Roles = append(mentions.Roles, token.ID)
+			}
+		case MarkupTokenTypeEveryoneMention, MarkupTokenTypeHereMention:
+			mentions.Everyone = true
+		}
+	}
+
+	return mentions
+}
+
+// Filter returns the mentions that will notify users when sent with these allowed mentions.
+// A nil receiver matches a message sent without allowed_mentions, which Discord parses for every mention.
+// A non-nil zero value allows nothing, matching an empty allowed_mentions object.
+func (am *MessageAllowedMentions) Filter(mentions ContentMentions) ContentMentions {
+	if am == nil {
+		return ContentMentions{
+			Everyone: mentions.Everyone,
+			Users:    append([]Snowflake(nil), mentions.Users...),
+			Roles:    append([]Snowflake(nil), mentions.Roles...),
+		}
+	}
+
+	parseUsers := slices.Contains(am.Parse, MessageAllowedMentionsTypeUsers)
+	parseRoles := slices.Contains(am.Parse, MessageAllowedMentionsTypeRoles)
+
+	filtered := ContentMentions{
+		Everyone: mentions.Everyone && slices.Contains(am.Parse, MessageAllowedMentionsTypeEveryone),
+	}
+
+	for _, userID := range mentions.Users {
+		if parseUsers || slices.Contains(am.Users, userID) {
+			filtered.Users = append(filtered.Users, userID)
+		}
+	}
+
+	for _, roleID := range mentions.Roles {
+		if parseRoles || slices.Contains(am.Roles, roleID) {
+			filtered.Roles = append(filtered.Roles, roleID)
+		}
+	}
+
+	return filtered
+}
+
+// AllowedMentions returns allowed mentions that permit exactly these mentions.
+func (cm ContentMentions) AllowedMentions() MessageAllowedMentions {
+	allowedMentions := MessageAllowedMentions{
+		Parse: []MessageAllowedMentionsType{},
+		Users: append([]Snowflake{}, cm.Users...),
+		Roles: append([]Snowflake{}, cm.Roles...),
+	}
+
+	if cm.Everyone {
+		allowedMentions.Parse = append(allowedMentions.Parse, MessageAllowedMentionsTypeEveryone)
+	}
+
+	return allowedMentions
+}
@@ -0,0 +1,457 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// message_limits.go contains validation of messages against discord's limits and a splitter
+// that breaks long messages into several that can be sent.
+
+// Message limits.
+const (
+	MaxMessageContentLength   = 2000
+	MaxMessageEmbeds          = 10
+	MaxMessageFiles           = 10
+	MaxEmbedTotalLength       = 6000
+	MaxEmbedTitleLength       = 256
+	MaxEmbedDescriptionLength = 4096
+	MaxEmbedFields            = 25
+	MaxEmbedFieldNameLength   = 256
+	MaxEmbedFieldValueLength  = 1024
+	MaxEmbedFooterTextLength  = 2048
+	MaxEmbedAuthorNameLength  = 256
+
+	// DefaultAttachmentSizeLimit is the maximum size of a file that can be uploaded to a guild
+	// without boosts. Interactions include the actual limit in Interaction.AttachmentSizeLimit.
+	DefaultAttachmentSizeLimit = 10 * 1024 * 1024
+)
+
+const codeBlockFence = "```"
+
+// MessageValidationError represents a field of a message that exceeds discord's limits,
+// at a path such as "embeds[0].fields[3].value".
+type MessageValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (e *MessageValidationError) Error() string {
+	return e.Path + ": " + e.Reason
+}
+
+func (e *MessageValidationError) Unwrap() error {
+	return ErrInvalidMessage
+}
+
+type messageValidator struct {
+	errs []error
+}
+
+func (mv *messageValidator) addError(path, format string, args ...any) {
+	mv.errs = append(mv.errs, &MessageValidationError{
+		Path:   path,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+func (mv *messageValidator) checkLength(path, value string, limit int) {
+	if length := utf8.RuneCountInString(value); length > limit {
+		mv.addError(path, "must be at most %d characters, got %d", limit, length)
+	}
+}
+
+// ValidateMessage checks message content, embeds and files against discord's limits.
+// attachmentSizeLimit: The maximum size of each file in bytes. If zero, DefaultAttachmentSizeLimit is used.
+// All errors are returned joined, each as a *MessageValidationError addressed by path.
+func ValidateMessage(content string, embeds []Embed, files []File, attachmentSizeLimit int) error {
+	validator := &messageValidator{}
+
+	validator.checkLength("content", content, MaxMessageContentLength)
+
+	if len(embeds) > MaxMessageEmbeds {
+		validator.addError("embeds", "must have at most %d embeds, got %d", MaxMessageEmbeds, len(embeds))
+	}
+
+	totalLength := 0
+
+	for i := range embeds {
+		validator.validateEmbed(fmt.Sprintf("embeds[%d]", i), &embeds[i])
+		totalLength += embeds[i].Length()
+	}
+
+	if totalLength > MaxEmbedTotalLength {
+		validator.addError("embeds", "total embed length must be at most %d characters, got %d", MaxEmbedTotalLength, totalLength)
+	}
+
+	if len(files) > MaxMessageFiles {
+		validator.addError("files", "must have at most %d files, got %d", MaxMessageFiles, len(files))
+	}
+
+	if attachmentSizeLimit <= 0 {
+		attachmentSizeLimit = DefaultAttachmentSizeLimit
+	}
+
+	for i, file := range files {
+		if size, ok := fileSize(file); ok && size > int64(attachmentSizeLimit) {
+			validator.addError(fmt.Sprintf("files[%d]", i), "%s is %d bytes, limit is %d", file.Name, size, attachmentSizeLimit)
+		}
+	}
+
+	return errors.Join(validator.errs...)
+}
+
+func (mv *messageValidator) validateEmbed(path string, embed *Embed) {
+	mv.checkLength(path+".title", embed.Title, MaxEmbedTitleLength)
+	mv.checkLength(path+".description", embed.Description, MaxEmbedDescriptionLength)
+
+	if len(embed.Fields) > MaxEmbedFields {
+		mv.addError(path+".fields", "must have at most %d fields, got %d", MaxEmbedFields, len(embed.Fields))
+	}
+
+	for i, field := range embed.Fields {
+		fieldPath := fmt.Sprintf("%s.fields[%d]", path, i)
+
+		if field.Name == "" {
+			mv.addError(fieldPath+".name", "is required")
+		}
+
+		if field.Value == "" {
+			mv.addError(fieldPath+".value", "is required")
+		}
+
+		mv.checkLength(fieldPath+".name", field.Name, MaxEmbedFieldNameLength)
+		mv.checkLength(fieldPath+".value", field.Value, MaxEmbedFieldValueLength)
+	}
+
+	if embed.Footer != nil {
+		mv.checkLength(path+".footer.text", embed.Footer.Text, MaxEmbedFooterTextLength)
+	}
+
+	if embed.Author != nil {
+		mv.checkLength(path+".author.name", embed.Author.Name, MaxEmbedAuthorNameLength)
+	}
+}
+
+// Length returns the number of characters in the embed that count towards the 6000 character
+// limit shared by all embeds in a message.
+func (e *Embed) Length() int {
+	length := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+
+	for _, field := range e.Fields {
+		length += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+	}
+
+	if e.Footer != nil {
+		length += utf8.RuneCountInString(e.Footer.Text)
+	}
+
+	if e.Author != nil {
+		length += utf8.RuneCountInString(e.Author.Name)
+	}
+
+	return length
+}
+
+// fileSize returns the size of a file if it can be determined without reading it.
+func fileSize(file File) (int64, bool) {
+	switch reader := file.Reader.(type) {
+	case interface{ Len() int }:
+		return int64(reader.Len()), true
+	case interface{ Size() int64 }:
+		return reader.Size(), true
+	case *os.File:
+		info, err := reader.Stat()
+		if err != nil {
+			return 0, false
+		}
+
+		return info.Size(), true
+	case io.Seeker:
+		current, err := reader.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+
+		end, err := reader.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, false
+		}
+
+		_, err = reader.Seek(current, io.SeekStart)
+		if err != nil {
+			return 0, false
+		}
+
+		return end - current, true
+	default:
+		return 0, false
+	}
+}
+
+// Validate checks the message against discord's limits, including its components.
+// attachmentSizeLimit: The maximum size of each file in bytes. If zero, DefaultAttachmentSizeLimit is used.
+func (m *MessageParams) Validate(attachmentSizeLimit int) error {
+	return errors.Join(
+		ValidateMessage(m.Content, m.Embeds, m.Files, attachmentSizeLimit),
+		m.ValidateComponents(),
+	)
+}
+
+// Validate checks the message against discord's limits, including its components.
+// attachmentSizeLimit: The maximum size of each file in bytes. If zero, DefaultAttachmentSizeLimit is used.
+func (w *WebhookMessageParams) Validate(attachmentSizeLimit int) error {
+	return errors.Join(
+		ValidateMessage(w.Content, w.Embeds, w.Files, attachmentSizeLimit),
+		w.ValidateComponents(),
+	)
+}
+
+// Validate checks the response against discord's limits, including its components. The
+// attachment size limit should be taken from Interaction.AttachmentSizeLimit.
+func (icd *InteractionCallbackData) Validate(attachmentSizeLimit int) error {
+	return errors.Join(
+		ValidateMessage(icd.Content, icd.Embeds, icd.Files, attachmentSizeLimit),
+		icd.ValidateComponents(),
+	)
+}
+
+// SplitContent splits content into chunks of at most limit characters. Content is split at
+// paragraph breaks, then line breaks, then spaces where possible. Code blocks that are split
+// are closed at the end of the chunk and reopened in the next, with the same language.
+// If limit is not positive, MaxMessageContentLength is used.
+func SplitContent(content string, limit int) []string {
+	if limit <= 0 {
+		limit = MaxMessageContentLength
+	}
+
+	chunks := make([]string, 0, 1)
+	openFence := ""
+
+	for {
+		text := content
+		prefixLength := 0
+
+		if openFence != "" {
+			text = openFence + "\n" + content
+			prefixLength = len(openFence) + 1
+		}
+
+		if utf8.RuneCountInString(text) <= limit {
+			chunks = append(chunks, text)
+
+			return chunks
+		}
+
+		// Code blocks can only be balanced if there is room to close one at the end of the chunk.
+		budget := limit - len("\n"+codeBlockFence)
+		balance := budget > prefixLength
+
+		if !balance {
+			// The limit is too small to balance code blocks, so split without them.
+			text = content
+			prefixLength = 0
+		}
+
+		cut, next := splitPoint(text, prefixLength, limit)
+		openFence = ""
+
+		if balance {
+			openFence = trailingOpenFence(text[:cut])
+			if openFence != "" {
+				// The chunk ends inside a code block, so split again leaving room to close it.
+				cut, next = splitPoint(text, prefixLength, budget)
+				openFence = trailingOpenFence(text[:cut])
+			}
+		}
+
+		chunk := text[:cut]
+		if openFence != "" {
+			chunk += "\n" + codeBlockFence
+		}
+
+		chunks = append(chunks, chunk)
+		content = text[next:]
+	}
+}
+
+// splitPoint returns the byte offset to end a chunk of at most budget characters at, and the
+// byte offset the next chunk starts at. The chunk always contains text after minimum.
+func splitPoint(text string, minimum, budget int) (int, int) {
+	end := 0
+
+	for i := 0; i < budget && end < len(text); i++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+
+	for _, separator := range []string{"\n\n", "\n", " "} {
+		// A separator starting right after the chunk is also a split point.
+		window := text[:min(end+len(separator), len(text))]
+
+		if i := strings.LastIndex(window, separator); i > minimum {
+			return i, i + len(separator)
+		}
+	}
+
+	return end, end
+}
+
+// trailingOpenFence returns the opening fence line of a code block that is still open at the
+// end of text, such as "```go", or an empty string if all code blocks are closed.
+func trailingOpenFence(text string) string {
+	openFence := ""
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		if !strings.HasPrefix(line, codeBlockFence) {
+			continue
+		}
+
+		if openFence == "" {
+			// A fence with content after the language, such as ```a```, opens and closes on one line.
+			if len(line) > len(codeBlockFence) && strings.HasSuffix(line, codeBlockFence) {
+				continue
+			}
+
+			openFence = strings.Fields(line)[0]
+		} else {
+			openFence = ""
+		}
+	}
+
+	return openFence
+}
+
+// SplitEmbeds groups embeds so that each group can be sent in one message, keeping to the
+// limit of 10 embeds and 6000 characters per message.
+func SplitEmbeds(embeds []Embed) [][]Embed {
+	groups := make([][]Embed, 0, 1)
+
+	var (
+		group  []Embed
+		length int
+	)
+
+	for _, embed := range embeds {
+		embedLength := embed.Length()
+
+		if len(group) > 0 && (len(group) == MaxMessageEmbeds || length+embedLength > MaxEmbedTotalLength) {
+			groups = append(groups, group)
+			group = nil
+			length = 0
+		}
+
+		group = append(group, embed)
+		length += embedLength
+	}
+
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// messagePart represents the content and embeds of one message produced by splitting.
+type messagePart struct {
+	content string
+	embeds  []Embed
+}
+
+// splitMessage splits content and embeds into parts. Content is sent first, with the first
+// group of embeds attached to the last content chunk.
+func splitMessage(content string, embeds []Embed) []messagePart {
+	parts := make([]messagePart, 0, 1)
+
+	if content != "" {
+		for _, chunk := range SplitContent(content, MaxMessageContentLength) {
+			parts = append(parts, messagePart{content: chunk})
+		}
+	}
+
+	for i, group := range SplitEmbeds(embeds) {
+		if i == 0 && len(parts) > 0 {
+			parts[len(parts)-1].embeds = group
+
+			continue
+		}
+
+		parts = append(parts, messagePart{embeds: group})
+	}
+
+	if len(parts) == 0 {
+		parts = append(parts, messagePart{})
+	}
+
+	return parts
+}
+
+// Split splits the message into several messages that are within discord's content and embed
+// limits. The message reference is kept on the first message and the files, attachments,
+// stickers and components are kept on the last.
+func (m *MessageParams) Split() []MessageParams {
+	parts := splitMessage(m.Content, m.Embeds)
+	messages := make([]MessageParams, len(parts))
+
+	for i, part := range parts {
+		message := MessageParams{
+			Content:         part.content,
+			Embeds:          part.embeds,
+			AllowedMentions: m.AllowedMentions,
+			Flags:           m.Flags,
+			TTS:             m.TTS,
+		}
+
+		if i == 0 {
+			message.MessageReference = m.MessageReference
+		}
+
+		if i == len(parts)-1 {
+			message.PayloadJSON = m.PayloadJSON
+			message.Components = m.Components
+			message.StickerIDs = m.StickerIDs
+			message.Files = m.Files
+			message.Attachments = m.Attachments
+		}
+
+		messages[i] = message
+	}
+
+	return messages
+}
+
+// Split splits the message into several messages that are within discord's content and embed
+// limits. The files, attachments and components are kept on the last message.
+func (w *WebhookMessageParams) Split() []WebhookMessageParams {
+	parts := splitMessage(w.Content, w.Embeds)
+	messages := make([]WebhookMessageParams, len(parts))
+
+	for i, part := range parts {
+		message := WebhookMessageParams{
+			Content:         part.content,
+			Embeds:          part.embeds,
+			Username:        w.Username,
+			AvatarURL:       w.AvatarURL,
+			AllowedMentions: w.AllowedMentions,
+			Flags:           w.Flags,
+			TTS:             w.TTS,
+		}
+
+		if i == len(parts)-1 {
+			message.PayloadJSON = w.PayloadJSON
+			message.Components = w.Components
+			message.Files = w.Files
+			message.Attachments = w.Attachments
+		}
+
+		messages[i] = message
+	}
+
+	return messages
+}
@@ -0,0 +1,97 @@
+package discord
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		limit   int
+		want    []string
+	}{
+		{
+			name:    "fits in one chunk",
+			content: "hello world",
+			limit:   20,
+			want:    []string{"hello world"},
+		},
+		{
+			name:    "prefers paragraph breaks",
+			content: "aaaa\n\nbbbb",
+			limit:   6,
+			want:    []string{"aaaa", "bbbb"},
+		},
+		{
+			name:    "splits at a separator right after the limit",
+			content: "aaa\n\nbbb",
+			limit:   3,
+			want:    []string{"aaa", "bbb"},
+		},
+		{
+			name:    "falls back to spaces",
+			content: "aaa bbb ccc",
+			limit:   8,
+			want:    []string{"aaa bbb", "ccc"},
+		},
+		{
+			name:    "cuts words without separators",
+			content: "abcdefgh",
+			limit:   3,
+			want:    []string{"abc", "def", "gh"},
+		},
+		{
+			name:    "counts characters rather than bytes",
+			content: "ééé ééé",
+			limit:   3,
+			want:    []string{"ééé", "ééé"},
+		},
+		{
+			name:    "closes and reopens code blocks with their language",
+			content: "```go\nline1\nline2\nline3\n```",
+			limit:   20,
+			want:    []string{"```go\nline1\n```", "```go\nline2\n```", "```go\nline3\n```"},
+		},
+		{
+			name:    "leaves closed code blocks alone",
+			content: "```\na\n```\ntext after",
+			limit:   12,
+			want:    []string{"```\na\n```", "text after"},
+		},
+		{
+			name:    "does not balance when the limit is too small",
+			content: "```\nab",
+			limit:   4,
+			want:    []string{"```", "ab"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitContent(tt.content, tt.limit)
+
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("SplitContent(%q, %d) = %q, want %q", tt.content, tt.limit, got, tt.want)
+			}
+
+			for _, chunk := range got {
+				if utf8.RuneCountInString(chunk) > tt.limit {
+					t.Errorf("chunk %q is longer than %d characters", chunk, tt.limit)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitContentDefaultLimit(t *testing.T) {
+	content := strings.Repeat("a", MaxMessageContentLength+1)
+
+	got := SplitContent(content, 0)
+	if len(got) != 2 || len(got[0]) != MaxMessageContentLength || got[1] != "a" {
+		t.Fatalf("SplitContent with limit 0 returned %d chunks, want chunks of %d and 1 characters", len(got), MaxMessageContentLength)
+	}
+}
@@ -0,0 +1,401 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+)
+
+// modal.go contains a builder for modals and a parser for modal submit interactions.
+
+// Modal limits.
+const (
+	MaxModalTitleLength           = 45
+	MaxModalComponents            = 5
+	MaxLabelLength                = 45
+	MaxLabelDescriptionLength     = 100
+	MaxTextInputLength            = 4000
+	MaxTextInputPlaceholderLength = 100
+	MaxFileUploadValues           = 10
+	MinRadioGroupOptions          = 2
+	MaxRadioGroupOptions          = 10
+	MaxCheckboxGroupOptions       = 10
+	MaxSelectOptions              = 25
+	MaxModalTextDisplayContent    = 4000
+)
+
+// ModalBuilder builds a modal response.
+type ModalBuilder struct {
+	CustomID   string
+	Title      string
+	Components []InteractionComponent
+}
+
+// NewModal creates a modal builder.
+// customID: The custom ID sent back when the modal is submitted.
+// title: The title of the modal, up to 45 characters.
+func NewModal(customID, title string) *ModalBuilder {
+	return &ModalBuilder{
+		CustomID: customID,
+		Title:    title,
+	}
+}
+
+// AddLabel adds a labelled component, such as a text input, select, file upload, radio group,
+// checkbox group or checkbox.
+func (mb *ModalBuilder) AddLabel(label, description string, component *InteractionComponent) *ModalBuilder {
+	mb.Components = append(mb.Components, *NewLabel(label, description, component))
+
+	return mb
+}
+
+// AddTextDisplay adds markdown text to the modal.
+func (mb *ModalBuilder) AddTextDisplay(content string) *ModalBuilder {
+	mb.Components = append(mb.Components, InteractionComponent{
+		Type:    InteractionComponentTypeTextDisplay,
+		Content: content,
+	})
+
+	return mb
+}
+
+// AddComponent adds a top level component to the modal.
+func (mb *ModalBuilder) AddComponent(component InteractionComponent) *ModalBuilder {
+	mb.Components = append(mb.Components, component)
+
+	return mb
+}
+
+// Validate checks the modal against discord's limits.
+func (mb *ModalBuilder) Validate() error {
+	if mb.CustomID == "" || len(mb.CustomID) > MaxCustomIDLength {
+		return fmt.Errorf("%w: custom_id must be 1-%d characters", ErrInvalidModal, MaxCustomIDLength)
+	}
+
+	if mb.Title == "" || utf8.RuneCountInString(mb.Title) > MaxModalTitleLength {
+		return fmt.Errorf("%w: title must be 1-%d characters", ErrInvalidModal, MaxModalTitleLength)
+	}
+
+	if len(mb.Components) == 0 || len(mb.Components) > MaxModalComponents {
+		return fmt.Errorf("%w: must have 1-%d components", ErrInvalidModal, MaxModalComponents)
+	}
+
+	customIDs := make(map[string]bool)
+
+	for i := range mb.Components {
+		err := validateModalComponent(&mb.Components[i], customIDs)
+		if err != nil {
+			return fmt.Errorf("components[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateModalComponent(component *InteractionComponent, customIDs map[string]bool) error {
+	switch component.Type {
+	case InteractionComponentTypeTextDisplay:
+		if component.Content == "" || utf8.RuneCountInString(component.Content) > MaxModalTextDisplayContent {
+			return fmt.Errorf("%w: text display content must be 1-%d characters", ErrInvalidModal, MaxModalTextDisplayContent)
+		}
+
+		return nil
+	case InteractionComponentTypeActionRow:
+		// Legacy layout, action rows may only contain a single text input.
+		if len(component.Components) != 1 || component.Components[0].Type != InteractionComponentTypeTextInput {
+			return fmt.Errorf("%w: action rows must contain a single text input", ErrInvalidModal)
+		}
+
+		if component.Components[0].Label == "" || utf8.RuneCountInString(component.Components[0].Label) > MaxLabelLength {
+			return fmt.Errorf("%w: text input label must be 1-%d characters", ErrInvalidModal, MaxLabelLength)
+		}
+
+		return validateModalInput(&component.Components[0], customIDs)
+	case InteractionComponentTypeLabel:
+		if component.Label == "" || utf8.RuneCountInString(component.Label) > MaxLabelLength {
+			return fmt.Errorf("%w: label must be 1-%d characters", ErrInvalidModal, MaxLabelLength)
+		}
+
+		if utf8.RuneCountInString(component.Description) > MaxLabelDescriptionLength {
+			return fmt.Errorf("%w: label description exceeds %d characters", ErrInvalidModal, MaxLabelDescriptionLength)
+		}
+
+		if component.Component == nil {
+			return fmt.Errorf("%w: label has no component", ErrInvalidModal)
+		}
+
+		return validateModalInput(component.Component, customIDs)
+	default:
+		return fmt.Errorf("%w: component type %d is not allowed at the top level of a modal", ErrInvalidModal, component.Type)
+	}
+}
+
+func validateModalInput(component *InteractionComponent, customIDs map[string]bool) error {
+	if component.CustomID == "" || len(component.CustomID) > MaxCustomIDLength {
+		return fmt.Errorf("%w: custom_id must be 1-%d characters", ErrInvalidModal, MaxCustomIDLength)
+	}
+
+	if customIDs[component.CustomID] {
+		return fmt.Errorf("%w: duplicate custom_id %s", ErrInvalidModal, component.CustomID)
+	}
+
+	customIDs[component.CustomID] = true
+
+	switch component.Type {
+	case InteractionComponentTypeTextInput:
+		minLength, maxLength := int32(0), int32(MaxTextInputLength)
+
+		if component.MinLength != nil {
+			minLength = *component.MinLength
+		}
+
+		if component.MaxLength != nil {
+			maxLength = *component.MaxLength
+		}
+
+		if minLength < 0 || minLength > MaxTextInputLength {
+			return fmt.Errorf("%w: min_length must be 0-%d", ErrInvalidModal, MaxTextInputLength)
+		}
+
+		if maxLength < 1 || maxLength > MaxTextInputLength {
+			return fmt.Errorf("%w: max_length must be 1-%d", ErrInvalidModal, MaxTextInputLength)
+		}
+
+		if minLength > maxLength {
+			return fmt.Errorf("%w: min_length is greater than max_length", ErrInvalidModal)
+		}
+
+		if utf8.RuneCountInString(component.Placeholder) > MaxTextInputPlaceholderLength {
+			return fmt.Errorf("%w: placeholder exceeds %d characters", ErrInvalidModal, MaxTextInputPlaceholderLength)
+		}
+
+		if len(component.Value) > 0 {
+			var value string
+
+			if json.Unmarshal(component.Value, &value) != nil {
+				return fmt.Errorf("%w: text input value must be a string", ErrInvalidModal)
+			}
+
+			if int32(utf8.RuneCountInString(value)) > maxLength {
+				return fmt.Errorf("%w: text input value exceeds max_length", ErrInvalidModal)
+			}
+		}
+	case InteractionComponentTypeStringSelect:
+		if len(component.Options) == 0 || len(component.Options) > MaxSelectOptions {
+			return fmt.Errorf("%w: select must have 1-%d options", ErrInvalidModal, MaxSelectOptions)
+		}
+	case InteractionComponentTypeUserInput, InteractionComponentTypeRoleSelect,
+		InteractionComponentTypeMentionableSelect, InteractionComponentTypeChannelSelect:
+	case InteractionComponentTypeFileUpload:
+		if component.MinValues != nil && (*component.MinValues < 0 || *component.MinValues > MaxFileUploadValues) {
+			return fmt.Errorf("%w: file upload min_values must be 0-%d", ErrInvalidModal, MaxFileUploadValues)
+		}
+
+		if component.MaxValues != nil && (*component.MaxValues < 1 || *component.MaxValues > MaxFileUploadValues) {
+			return fmt.Errorf("%w: file upload max_values must be 1-%d", ErrInvalidModal, MaxFileUploadValues)
+		}
+	case InteractionComponentTypeRadioGroup:
+		if len(component.Options) < MinRadioGroupOptions || len(component.Options) > MaxRadioGroupOptions {
+			return fmt.Errorf("%w: radio group must have %d-%d options", ErrInvalidModal, MinRadioGroupOptions, MaxRadioGroupOptions)
+		}
+	case InteractionComponentTypeCheckboxGroup:
+		if len(component.Options) == 0 || len(component.Options) > MaxCheckboxGroupOptions {
+			return fmt.Errorf("%w: checkbox group must have 1-%d options", ErrInvalidModal, MaxCheckboxGroupOptions)
+		}
+	case InteractionComponentTypeCheckbox:
+	default:
+		return fmt.Errorf("%w: component type %d is not allowed in a modal", ErrInvalidModal, component.Type)
+	}
+
+	return nil
+}
+
+// Build validates the modal and returns the interaction response to send it.
+func (mb *ModalBuilder) Build() (*InteractionResponse, error) {
+	err := mb.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &InteractionResponse{
+		Type: InteractionCallbackTypeModal,
+		Data: &InteractionCallbackData{
+			CustomID:   mb.CustomID,
+			Title:      mb.Title,
+			Components: mb.Components,
+		},
+	}, nil
+}
+
+// NewLabel creates a label component wrapping a modal input.
+func NewLabel(label, description string, component *InteractionComponent) *InteractionComponent {
+	return &InteractionComponent{
+		Type:        InteractionComponentTypeLabel,
+		Label:       label,
+		Description: description,
+		Component:   component,
+	}
+}
+
+// NewTextInput creates a text input component.
+// style: InteractionComponentStyleShort or InteractionComponentStyleParagraph.
+func NewTextInput(customID string, style InteractionComponentStyle) *InteractionComponent {
+	return &InteractionComponent{
+		Type:     InteractionComponentTypeTextInput,
+		CustomID: customID,
+		Style:    style,
+	}
+}
+
+func (ic *InteractionComponent) SetMinMaxLength(minLength, maxLength *int32) *InteractionComponent {
+	ic.MinLength = minLength
+	ic.MaxLength = maxLength
+
+	return ic
+}
+
+func (ic *InteractionComponent) SetRequired(required bool) *InteractionComponent {
+	ic.Required = &required
+
+	return ic
+}
+
+// SetValue sets the pre-filled value of a text input.
+func (ic *InteractionComponent) SetValue(value string) *InteractionComponent {
+	ic.Value, _ = json.Marshal(value)
+
+	return ic
+}
+
+// ModalSubmit represents the submitted values of a modal, keyed by custom ID.
+type ModalSubmit struct {
+	Components map[string]InteractionComponent
+	Resolved   *InteractionResolvedData
+	CustomID   string
+}
+
+// ParseModalSubmit collects the submitted components of a modal submit interaction.
+func ParseModalSubmit(data *InteractionData) *ModalSubmit {
+	modalSubmit := &ModalSubmit{
+		Components: make(map[string]InteractionComponent),
+	}
+
+	if data == nil {
+		return modalSubmit
+	}
+
+	modalSubmit.CustomID = data.CustomID
+	modalSubmit.Resolved = data.Resolved
+
+	collectModalComponents(data.Components, modalSubmit.Components)
+
+	return modalSubmit
+}
+
+func collectModalComponents(components []InteractionComponent, collected map[string]InteractionComponent) {
+	for _, component := range components {
+		if component.CustomID != "" {
+			collected[component.CustomID] = component
+		}
+
+		if component.Component != nil {
+			collectModalComponents([]InteractionComponent{*component.Component}, collected)
+		}
+
+		collectModalComponents(component.Components, collected)
+	}
+}
+
+// Has returns if a component with the custom ID was submitted.
+func (ms *ModalSubmit) Has(customID string) bool {
+	_, ok := ms.Components[customID]
+
+	return ok
+}
+
+// Text returns the value of a text input.
+func (ms *ModalSubmit) Text(customID string) (string, bool) {
+	component, ok := ms.Components[customID]
+	if !ok || len(component.Value) == 0 {
+		return "", false
+	}
+
+	var value string
+
+	if json.Unmarshal(component.Value, &value) != nil {
+		return "", false
+	}
+
+	return value, true
+}
+
+// Radio returns the selected value of a radio group. Returns false if nothing was selected.
+func (ms *ModalSubmit) Radio(customID string) (string, bool) {
+	return ms.Text(customID)
+}
+
+// Checkbox returns if a checkbox was checked.
+func (ms *ModalSubmit) Checkbox(customID string) (bool, bool) {
+	component, ok := ms.Components[customID]
+	if !ok || len(component.Value) == 0 {
+		return false, false
+	}
+
+	var value bool
+
+	if json.Unmarshal(component.Value, &value) != nil {
+		return false, false
+	}
+
+	return value, true
+}
+
+// Values returns the selected values of a select or checkbox group.
+func (ms *ModalSubmit) Values(customID string) ([]string, bool) {
+	component, ok := ms.Components[customID]
+	if !ok {
+		return nil, false
+	}
+
+	return component.Values, true
+}
+
+// AttachmentIDs returns the IDs of the files uploaded to a file upload. Returns false if the
+// component was not submitted or any of its values is not a valid ID.
+func (ms *ModalSubmit) AttachmentIDs(customID string) ([]Snowflake, bool) {
+	values, ok := ms.Values(customID)
+	if !ok {
+		return nil, false
+	}
+
+	ids := make([]Snowflake, 0, len(values))
+
+	for _, value := range values {
+		id, err := parseSnowflake(value)
+		if err != nil {
+			return nil, false
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, true
+}
+
+// Attachments returns the resolved files uploaded to a file upload.
+func (ms *ModalSubmit) Attachments(customID string) ([]MessageAttachment, bool) {
+	ids, ok := ms.AttachmentIDs(customID)
+	if !ok || ms.Resolved == nil {
+		return nil, false
+	}
+
+	attachments := make([]MessageAttachment, 0, len(ids))
+
+	for _, id := range ids {
+		if attachment, ok := ms.Resolved.Attachments[id]; ok {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments, true
+}
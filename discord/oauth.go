@@ -1,6 +1,58 @@
 package discord
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// oauth.go contains the structures used for OAuth2 authorization.
+
+// OAuth2Scope represents a scope that can be requested during OAuth2 authorization.
+type OAuth2Scope string
+
+const (
+	OAuth2ScopeActivitiesRead                        OAuth2Scope = "activities.read"
+	OAuth2ScopeActivitiesWrite                       OAuth2Scope = "activities.write"
+	OAuth2ScopeApplicationsBuildsRead                OAuth2Scope = "applications.builds.read"
+	OAuth2ScopeApplicationsBuildsUpload              OAuth2Scope = "applications.builds.upload"
+	OAuth2ScopeApplicationsCommands                  OAuth2Scope = "applications.commands"
+	OAuth2ScopeApplicationsCommandsUpdate            OAuth2Scope = "applications.commands.update"
+	OAuth2ScopeApplicationsCommandsPermissionsUpdate OAuth2Scope = "applications.commands.permissions.update"
+	OAuth2ScopeApplicationsEntitlements              OAuth2Scope = "applications.entitlements"
+	OAuth2ScopeApplicationsStoreUpdate               OAuth2Scope = "applications.store.update"
+	OAuth2ScopeBot                                   OAuth2Scope = "bot"
+	OAuth2ScopeConnections                           OAuth2Scope = "connections"
+	OAuth2ScopeDMChannelsRead                        OAuth2Scope = "dm_channels.read"
+	OAuth2ScopeEmail                                 OAuth2Scope = "email"
+	OAuth2ScopeGDMJoin                               OAuth2Scope = "gdm.join"
+	OAuth2ScopeGuilds                                OAuth2Scope = "guilds"
+	OAuth2ScopeGuildsJoin                            OAuth2Scope = "guilds.join"
+	OAuth2ScopeGuildsMembersRead                     OAuth2Scope = "guilds.members.read"
+	OAuth2ScopeIdentify                              OAuth2Scope = "identify"
+	OAuth2ScopeMessagesRead                          OAuth2Scope = "messages.read"
+	OAuth2ScopeRelationshipsRead                     OAuth2Scope = "relationships.read"
+	OAuth2ScopeRoleConnectionsWrite                  OAuth2Scope = "role_connections.write"
+	OAuth2ScopeRPC                                   OAuth2Scope = "rpc"
+	OAuth2ScopeRPCActivitiesWrite                    OAuth2Scope = "rpc.activities.write"
+	OAuth2ScopeRPCNotificationsRead                  OAuth2Scope = "rpc.notifications.read"
+	OAuth2ScopeRPCVoiceRead                          OAuth2Scope = "rpc.voice.read"
+	OAuth2ScopeRPCVoiceWrite                         OAuth2Scope = "rpc.voice.write"
+	OAuth2ScopeVoice                                 OAuth2Scope = "voice"
+	OAuth2ScopeWebhookIncoming                       OAuth2Scope = "webhook.incoming"
+)
+
+// OAuth2 grant types.
+const (
+	OAuth2GrantTypeAuthorizationCode = "authorization_code"
+	OAuth2GrantTypeRefreshToken      = "refresh_token"
+	OAuth2GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuth2 token type hints, used when revoking a token.
+const (
+	OAuth2TokenTypeHintAccessToken  = "access_token"
+	OAuth2TokenTypeHintRefreshToken = "refresh_token"
+)
 
 // AuthorizationInformation represents the current oauth authorization.
 type AuthorizationInformation struct {
@@ -9,3 +61,142 @@ type AuthorizationInformation struct {
 	User        User        `json:"user"`
 	Scopes      []string    `json:"scopes"`
 }
+
+// AccessToken represents an OAuth2 access token.
+type AccessToken struct {
+	// ExpiresAt is when the access token expires, calculated from ExpiresIn when it was received.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Guild is the guild the bot was added to, if the bot scope was authorized.
+	Guild *Guild `json:"guild,omitempty"`
+
+	// Webhook is the webhook that was created, if the webhook.incoming scope was authorized.
+	Webhook *Webhook `json:"webhook,omitempty"`
+
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+
+	// ExpiresIn is the number of seconds the access token was valid for when it was received.
+	ExpiresIn int32 `json:"expires_in"`
+}
+
+// Scopes returns the scopes the access token was granted.
+func (at *AccessToken) Scopes() []OAuth2Scope {
+	fields := strings.Fields(at.Scope)
+	scopes := make([]OAuth2Scope, len(fields))
+
+	for i, field := range fields {
+		scopes[i] = OAuth2Scope(field)
+	}
+
+	return scopes
+}
+
+// HasScope returns if the access token was granted a scope.
+func (at *AccessToken) HasScope(scope OAuth2Scope) bool {
+	for _, field := range strings.Fields(at.Scope) {
+		if OAuth2Scope(field) == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expired returns if the access token has expired, or will within the leeway.
+func (at *AccessToken) Expired(leeway time.Duration) bool {
+	return !at.ExpiresAt.IsZero() && !time.Now().Add(leeway).Before(at.ExpiresAt)
+}
+
+// Authorization returns the value of the Authorization header for the access token.
+func (at *AccessToken) Authorization() string {
+	tokenType := at.TokenType
+	if tokenType == "" || strings.EqualFold(tokenType, "bearer") {
+		tokenType = "Bearer"
+	}
+
+	return tokenType + " " + at.AccessToken
+}
+
+// OAuth2Error represents an error returned by the OAuth2 token endpoints.
+type OAuth2Error struct {
+	// Err is the underlying request error.
+	Err error `json:"-"`
+
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (oe *OAuth2Error) Error() string {
+	if oe.Description == "" {
+		return "oauth2: " + oe.Code
+	}
+
+	return "oauth2: " + oe.Code + ": " + oe.Description
+}
+
+func (oe *OAuth2Error) Unwrap() error {
+	return oe.Err
+}
+
+// AuthorizeURLParams represents the parameters of an OAuth2 authorization URL.
+type AuthorizeURLParams struct {
+	// Permissions are the permissions requested for the bot, if the bot scope is requested.
+	Permissions *Int64
+
+	// GuildID preselects the guild the bot is added to, if the bot or webhook.incoming scope is requested.
+	GuildID *Snowflake
+
+	// IntegrationType is where the application is installed.
+	IntegrationType *ApplicationIntegrationType
+
+	// State is a unique value returned to the redirect URI, used to prevent CSRF.
+	State string
+
+	// Prompt is "consent" to always show the authorization screen, or "none" to skip it if the
+	// user has already authorized the application.
+	Prompt string
+
+	// RedirectURI overrides the redirect URI of the client.
+	RedirectURI string
+
+	Scopes []OAuth2Scope
+
+	// DisableGuildSelect prevents the user from changing the guild selected by GuildID.
+	DisableGuildSelect bool
+}
+
+// OAuth2Client represents an application's OAuth2 credentials, used to build authorization URLs
+// and to exchange and refresh access tokens.
+type OAuth2Client struct {
+	// Session is used to make requests to the token endpoints. Its token is not sent, as the
+	// client authenticates with its ID and secret instead.
+	Session *Session
+
+	ClientSecret string
+	RedirectURI  string
+	ClientID     Snowflake
+}
+
+// NewOAuth2Client creates an OAuth2 client for an application.
+func NewOAuth2Client(session *Session, clientID Snowflake, clientSecret, redirectURI string) *OAuth2Client {
+	return &OAuth2Client{
+		Session:      session,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURI:  redirectURI,
+	}
+}
+
+// joinScopes returns scopes as a space separated list.
+func joinScopes(scopes []OAuth2Scope) string {
+	values := make([]string, len(scopes))
+
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+
+	return strings.Join(values, " ")
+}
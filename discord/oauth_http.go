@@ -2,8 +2,13 @@ package discord
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 func GetCurrentBotApplicationInformation(ctx context.Context, session *Session) (*Application, error) {
@@ -31,3 +36,165 @@ func GetCurrentAuthorizationInformation(ctx context.Context, session *Session) (
 
 	return authorizationInformation, nil
 }
+
+// AuthorizeURL returns the URL users are sent to for authorizing the application.
+func (oc *OAuth2Client) AuthorizeURL(params AuthorizeURLParams) string {
+	values := url.Values{}
+
+	values.Set("client_id", oc.ClientID.String())
+
+	if len(params.Scopes) > 0 {
+		values.Set("scope", joinScopes(params.Scopes))
+	}
+
+	redirectURI := params.RedirectURI
+	if redirectURI == "" {
+		redirectURI = oc.RedirectURI
+	}
+
+	if redirectURI != "" {
+		values.Set("response_type", "code")
+		values.Set("redirect_uri", redirectURI)
+	}
+
+	if params.State != "" {
+		values.Set("state", params.State)
+	}
+
+	if params.Prompt != "" {
+		values.Set("prompt", params.Prompt)
+	}
+
+	if params.Permissions != nil {
+		values.Set("permissions", params.Permissions.String())
+	}
+
+	if params.GuildID != nil {
+		values.Set("guild_id", params.GuildID.String())
+	}
+
+	if params.DisableGuildSelect {
+		values.Set("disable_guild_select", "true")
+	}
+
+	if params.IntegrationType != nil {
+		values.Set("integration_type", strconv.FormatUint(uint64(*params.IntegrationType), 10))
+	}
+
+	return EndpointDiscord + EndpointOAuth2Authorize + "?" + values.Encode()
+}
+
+// ExchangeCode exchanges the code returned to the redirect URI for an access token.
+// redirectURI: The redirect URI used in the authorization URL. Defaults to the client's redirect URI.
+func (oc *OAuth2Client) ExchangeCode(ctx context.Context, code string, redirectURI string) (*AccessToken, error) {
+	if redirectURI == "" {
+		redirectURI = oc.RedirectURI
+	}
+
+	values := url.Values{}
+	values.Set("grant_type", OAuth2GrantTypeAuthorizationCode)
+	values.Set("code", code)
+	values.Set("redirect_uri", redirectURI)
+
+	accessToken, err := oc.token(ctx, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	return accessToken, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access token. The refresh token is rotated,
+// so the one returned must be stored in place of the previous one.
+func (oc *OAuth2Client) RefreshToken(ctx context.Context, refreshToken string) (*AccessToken, error) {
+	values := url.Values{}
+	values.Set("grant_type", OAuth2GrantTypeRefreshToken)
+	values.Set("refresh_token", refreshToken)
+
+	accessToken, err := oc.token(ctx, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	return accessToken, nil
+}
+
+// ClientCredentials returns an access token for the user that owns the application. If the
+// application is owned by a team, only the identify and applications.commands.update scopes can be requested.
+func (oc *OAuth2Client) ClientCredentials(ctx context.Context, scopes ...OAuth2Scope) (*AccessToken, error) {
+	values := url.Values{}
+	values.Set("grant_type", OAuth2GrantTypeClientCredentials)
+	values.Set("scope", joinScopes(scopes))
+
+	accessToken, err := oc.token(ctx, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client credentials: %w", err)
+	}
+
+	return accessToken, nil
+}
+
+// RevokeToken revokes an access or refresh token. Revoking either revokes both.
+// tokenTypeHint: OAuth2TokenTypeHintAccessToken or OAuth2TokenTypeHintRefreshToken, if known.
+func (oc *OAuth2Client) RevokeToken(ctx context.Context, token string, tokenTypeHint string) error {
+	values := url.Values{}
+	values.Set("token", token)
+
+	if tokenTypeHint != "" {
+		values.Set("token_type_hint", tokenTypeHint)
+	}
+
+	_, err := oc.fetch(ctx, EndpointOAuth2TokenRevoke, values)
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
+
+// token requests an access token and sets when it expires.
+func (oc *OAuth2Client) token(ctx context.Context, values url.Values) (*AccessToken, error) {
+	now := time.Now()
+
+	response, err := oc.fetch(ctx, EndpointOAuth2Token, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessToken *AccessToken
+
+	err = json.Unmarshal(response, &accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if accessToken.ExpiresIn > 0 {
+		accessToken.ExpiresAt = now.Add(time.Duration(accessToken.ExpiresIn) * time.Second)
+	}
+
+	return accessToken, nil
+}
+
+// fetch posts a form to a token endpoint, authenticating with the client's ID and secret.
+// Errors returned by the endpoint are returned as an OAuth2Error.
+func (oc *OAuth2Client) fetch(ctx context.Context, endpoint string, values url.Values) ([]byte, error) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(oc.ClientID.String()+":"+oc.ClientSecret)))
+
+	session := NewSession("", oc.Session.Interface)
+
+	response, err := session.Interface.Fetch(ctx, session, http.MethodPost, endpoint, "application/x-www-form-urlencoded", []byte(values.Encode()), headers)
+	if err != nil {
+		var oauth2Error OAuth2Error
+
+		if json.Unmarshal(response, &oauth2Error) == nil && oauth2Error.Code != "" {
+			oauth2Error.Err = err
+
+			return nil, &oauth2Error
+		}
+
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,167 @@
+package discord
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// optional.go contains types for request fields that need to tell apart a field that is not sent
+// from one that is sent as null or as its zero value.
+
+// Optional represents a JSON field that is either omitted or set to a value. The zero value is
+// omitted, so fields must be tagged omitempty. Unlike a pointer, an Optional slice can be set to an
+// empty list.
+//
+// Optional is backed by a map so encoding/json omits it when unset, as Go 1.22 has no way to omit
+// an unset struct. It should be created with NewOptional rather than as a literal. Marshaling an
+// Optional with only a false key returns ErrInvalidOptional rather than sending a zero value.
+type Optional[T any] map[bool]T
+
+// NewOptional returns an Optional set to a value.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{true: value}
+}
+
+// OptionalFromPointer returns an Optional set to the value of a pointer, or unset if it is nil.
+func OptionalFromPointer[T any](value *T) Optional[T] {
+	if value == nil {
+		return nil
+	}
+
+	return NewOptional(*value)
+}
+
+// IsSet returns if the field will be sent.
+func (o Optional[T]) IsSet() bool {
+	_, ok := o[true]
+
+	return ok
+}
+
+// Get returns the value of the field and if it is set.
+func (o Optional[T]) Get() (T, bool) {
+	value, ok := o[true]
+
+	return value, ok
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	value, ok := o[true]
+	if !ok || len(o) != 1 {
+		return nil, ErrInvalidOptional
+	}
+
+	return marshalValue(value)
+}
+
+// UnmarshalJSON sets the field to the value, or leaves it unset if the value is null.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null) {
+		*o = nil
+
+		return nil
+	}
+
+	var value T
+
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*o = NewOptional(value)
+
+	return nil
+}
+
+// Nullable represents a JSON field that is either omitted, null or set to a value. The zero
+// value is omitted, so fields must be tagged omitempty. Sending null clears the field, such as
+// removing a channel from its category or removing a member's timeout.
+//
+// Nullable is backed by a map so encoding/json omits it when unset, as Go 1.22 has no way to omit
+// an unset struct. It should be created with NewNullable or Null rather than as a literal.
+// Marshaling a Nullable with both keys returns ErrInvalidOptional, as it is both null and set.
+type Nullable[T any] map[bool]T
+
+// NewNullable returns a Nullable set to a value.
+func NewNullable[T any](value T) Nullable[T] {
+	return Nullable[T]{true: value}
+}
+
+// Null returns a Nullable that is sent as null.
+func Null[T any]() Nullable[T] {
+	var zero T
+
+	return Nullable[T]{false: zero}
+}
+
+// NullableFromPointer returns a Nullable set to the value of a pointer, or null if it is nil.
+func NullableFromPointer[T any](value *T) Nullable[T] {
+	if value == nil {
+		return Null[T]()
+	}
+
+	return NewNullable(*value)
+}
+
+// IsSet returns if the field will be sent, either as null or a value.
+func (n Nullable[T]) IsSet() bool {
+	return len(n) > 0
+}
+
+// IsNull returns if the field will be sent as null.
+func (n Nullable[T]) IsNull() bool {
+	_, ok := n[false]
+
+	return ok
+}
+
+// Get returns the value of the field and if it is set to a value.
+func (n Nullable[T]) Get() (T, bool) {
+	value, ok := n[true]
+
+	return value, ok
+}
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if len(n) != 1 {
+		return nil, ErrInvalidOptional
+	}
+
+	value, ok := n[true]
+	if !ok {
+		return null, nil
+	}
+
+	return marshalValue(value)
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null) {
+		*n = Null[T]()
+
+		return nil
+	}
+
+	var value T
+
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*n = NewNullable(value)
+
+	return nil
+}
+
+// marshalValue marshals a set value. Nil slices are sent as an empty list, as a set value is
+// never meant to be null.
+func marshalValue(value any) ([]byte, error) {
+	if reflectValue := reflect.ValueOf(value); reflectValue.Kind() == reflect.Slice && reflectValue.IsNil() {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(value)
+}
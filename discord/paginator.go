@@ -0,0 +1,420 @@
+package discord
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// paginator.go contains a paginator that displays pages of embeds with buttons to navigate
+// between them.
+
+const (
+	// PaginatorCustomIDPrefix prefixes the custom IDs of paginator buttons and modals.
+	PaginatorCustomIDPrefix = "paginator"
+
+	// DefaultPaginatorTimeout is how long a paginator waits for input before disabling its buttons.
+	DefaultPaginatorTimeout = 5 * time.Minute
+
+	paginatorActionFirst    = "first"
+	paginatorActionPrevious = "prev"
+	paginatorActionNext     = "next"
+	paginatorActionLast     = "last"
+	paginatorActionJump     = "jump"
+	paginatorActionPage     = "page"
+	paginatorPageInputID    = "page"
+)
+
+// PageFunc returns the embed for a page. Pages are numbered from zero.
+type PageFunc func(ctx context.Context, page int) (*Embed, error)
+
+// Paginator displays one page at a time with first, previous, next, last and jump buttons.
+// Paginators are sent and driven by a PaginatorManager.
+type Paginator struct {
+	// Pages produces the embed for each page.
+	Pages PageFunc
+
+	timer *time.Timer
+
+	// lastInteraction is the most recent interaction used to update the message. Its token is
+	// used to edit ephemeral messages once the paginator times out.
+	lastInteraction *Interaction
+	lastReceivedAt  time.Time
+
+	embed *Embed
+
+	id string
+
+	// PageCount is the number of pages.
+	PageCount int
+
+	// Timeout is how long to wait for input before disabling the buttons. Each button press
+	// resets the timeout. Defaults to DefaultPaginatorTimeout.
+	Timeout time.Duration
+
+	// UserID is the user allowed to control the paginator. If zero, anyone can.
+	UserID Snowflake
+
+	channelID Snowflake
+	messageID Snowflake
+
+	page int
+
+	// Ephemeral sends the paginator as an ephemeral message. Only applies to interaction responses.
+	Ephemeral bool
+
+	expired bool
+
+	mu sync.Mutex
+}
+
+// NewPaginator creates a paginator that produces pages with a function.
+func NewPaginator(pages PageFunc, pageCount int) *Paginator {
+	return &Paginator{
+		Pages:     pages,
+		PageCount: pageCount,
+		Timeout:   DefaultPaginatorTimeout,
+	}
+}
+
+// NewEmbedPaginator creates a paginator over a fixed list of embeds.
+func NewEmbedPaginator(embeds []Embed) *Paginator {
+	return NewPaginator(func(_ context.Context, page int) (*Embed, error) {
+		return &embeds[page], nil
+	}, len(embeds))
+}
+
+func (p *Paginator) SetUserID(userID Snowflake) *Paginator {
+	p.UserID = userID
+
+	return p
+}
+
+func (p *Paginator) SetTimeout(timeout time.Duration) *Paginator {
+	p.Timeout = timeout
+
+	return p
+}
+
+func (p *Paginator) SetEphemeral(ephemeral bool) *Paginator {
+	p.Ephemeral = ephemeral
+
+	return p
+}
+
+// Page returns the current page.
+func (p *Paginator) Page() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.page
+}
+
+func (p *Paginator) customID(action string) string {
+	return PaginatorCustomIDPrefix + ComponentCustomIDSeparator + p.id + ComponentCustomIDSeparator + action
+}
+
+// components returns the navigation buttons for the current page.
+func (p *Paginator) components(disabled bool) []InteractionComponent {
+	atStart := p.page == 0
+	atEnd := p.page >= p.PageCount-1
+
+	return []InteractionComponent{
+		*NewActionRow(
+			*NewButton(InteractionComponentStyleSecondary, "«", p.customID(paginatorActionFirst)).SetDisabled(disabled || atStart),
+			*NewButton(InteractionComponentStylePrimary, "‹", p.customID(paginatorActionPrevious)).SetDisabled(disabled || atStart),
+			*NewButton(InteractionComponentStyleSecondary, fmt.Sprintf("%d/%d", p.page+1, p.PageCount), p.customID(paginatorActionJump)).SetDisabled(disabled || p.PageCount <= 1),
+			*NewButton(InteractionComponentStylePrimary, "›", p.customID(paginatorActionNext)).SetDisabled(disabled || atEnd),
+			*NewButton(InteractionComponentStyleSecondary, "»", p.customID(paginatorActionLast)).SetDisabled(disabled || atEnd),
+		),
+	}
+}
+
+// render fetches the embed for the current page.
+func (p *Paginator) render(ctx context.Context) error {
+	embed, err := p.Pages(ctx, p.page)
+	if err != nil {
+		return fmt.Errorf("failed to get page %d: %w", p.page, err)
+	}
+
+	p.embed = embed
+
+	return nil
+}
+
+func (p *Paginator) embeds() []Embed {
+	if p.embed == nil {
+		return []Embed{}
+	}
+
+	return []Embed{*p.embed}
+}
+
+// PaginatorManager keeps track of active paginators and handles their button interactions.
+type PaginatorManager struct {
+	Session *Session
+
+	paginators map[string]*Paginator
+
+	mu sync.RWMutex
+}
+
+// NewPaginatorManager creates a paginator manager. Register must be called to route
+// button presses to the manager.
+func NewPaginatorManager(session *Session) *PaginatorManager {
+	return &PaginatorManager{
+		Session:    session,
+		paginators: make(map[string]*Paginator),
+	}
+}
+
+// Register registers the manager's handler with a component router.
+func (pm *PaginatorManager) Register(router *ComponentRouter) *PaginatorManager {
+	router.Register(PaginatorCustomIDPrefix+ComponentCustomIDSeparator+"{id}"+ComponentCustomIDSeparator+"{action}", pm.HandleComponent)
+
+	return pm
+}
+
+func (pm *PaginatorManager) start(ctx context.Context, paginator *Paginator) error {
+	if paginator.PageCount <= 0 {
+		return fmt.Errorf("%w: paginator has no pages", ErrInvalidMessage)
+	}
+
+	id := make([]byte, 8)
+
+	_, err := rand.Read(id)
+	if err != nil {
+		return fmt.Errorf("failed to generate paginator id: %w", err)
+	}
+
+	paginator.id = hex.EncodeToString(id)
+	paginator.page = 0
+	paginator.expired = false
+
+	if paginator.Timeout <= 0 {
+		paginator.Timeout = DefaultPaginatorTimeout
+	}
+
+	return paginator.render(ctx)
+}
+
+func (pm *PaginatorManager) track(paginator *Paginator) {
+	pm.mu.Lock()
+	pm.paginators[paginator.id] = paginator
+	pm.mu.Unlock()
+
+	paginator.timer = time.AfterFunc(paginator.Timeout, func() {
+		pm.expire(paginator)
+	})
+}
+
+// Send sends a paginator to a channel.
+func (pm *PaginatorManager) Send(ctx context.Context, channelID Snowflake, paginator *Paginator) (*Message, error) {
+	paginator.mu.Lock()
+	defer paginator.mu.Unlock()
+
+	err := pm.start(ctx, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := CreateMessage(ctx, pm.Session, channelID, MessageParams{
+		Embeds:     paginator.embeds(),
+		Components: paginator.components(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paginator.channelID = message.ChannelID
+	paginator.messageID = message.ID
+
+	pm.track(paginator)
+
+	return message, nil
+}
+
+// Respond sends a paginator as the response to an interaction. If the paginator has no UserID,
+// only the user who triggered the interaction can control it.
+func (pm *PaginatorManager) Respond(ctx context.Context, interaction *Interaction, paginator *Paginator) error {
+	paginator.mu.Lock()
+	defer paginator.mu.Unlock()
+
+	err := pm.start(ctx, paginator)
+	if err != nil {
+		return err
+	}
+
+	if paginator.UserID.IsNil() {
+		if user := interaction.GetUser(); user != nil {
+			paginator.UserID = user.ID
+		}
+	}
+
+	data := &InteractionCallbackData{
+		Embeds:     paginator.embeds(),
+		Components: paginator.components(false),
+	}
+
+	if paginator.Ephemeral {
+		data.Flags = uint32(MessageFlagEphemeral)
+	}
+
+	callbackResponse, err := CreateInteractionResponseWithCallback(ctx, pm.Session, interaction.ID, interaction.Token, InteractionResponse{
+		Type: InteractionCallbackTypeChannelMessageSource,
+		Data: data,
+	})
+	if err != nil {
+		return err
+	}
+
+	paginator.lastInteraction = interaction
+	paginator.lastReceivedAt = time.Now()
+
+	if callbackResponse != nil && !paginator.Ephemeral {
+		var resource struct {
+			Message *Message `json:"message"`
+		}
+
+		if json.Unmarshal(callbackResponse.Resource, &resource) == nil && resource.Message != nil {
+			paginator.channelID = resource.Message.ChannelID
+			paginator.messageID = resource.Message.ID
+		}
+	}
+
+	pm.track(paginator)
+
+	return nil
+}
+
+// HandleComponent handles the button presses and page jump modals of paginators.
+// This matches ComponentHandler.
+func (pm *PaginatorManager) HandleComponent(ctx context.Context, interaction *Interaction, args ComponentArgs) (*InteractionResponse, error) {
+	pm.mu.RLock()
+	paginator, ok := pm.paginators[args.Param("id")]
+	pm.mu.RUnlock()
+
+	if !ok {
+		return paginatorNotice("This paginator has expired."), nil
+	}
+
+	paginator.mu.Lock()
+	defer paginator.mu.Unlock()
+
+	if paginator.expired {
+		return paginatorNotice("This paginator has expired."), nil
+	}
+
+	if user := interaction.GetUser(); !paginator.UserID.IsNil() && (user == nil || user.ID != paginator.UserID) {
+		return paginatorNotice("You cannot control this paginator."), nil
+	}
+
+	page := paginator.page
+
+	switch args.Param("action") {
+	case paginatorActionFirst:
+		page = 0
+	case paginatorActionPrevious:
+		page--
+	case paginatorActionNext:
+		page++
+	case paginatorActionLast:
+		page = paginator.PageCount - 1
+	case paginatorActionJump:
+		return NewModal(paginator.customID(paginatorActionPage), "Jump to page").
+			AddLabel(fmt.Sprintf("Page (1-%d)", paginator.PageCount), "", NewTextInput(paginatorPageInputID, InteractionComponentStyleShort).
+				SetValue(strconv.Itoa(paginator.page+1))).
+			Build()
+	case paginatorActionPage:
+		value, _ := ParseModalSubmit(interaction.Data).Text(paginatorPageInputID)
+
+		number, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || number < 1 || number > paginator.PageCount {
+			return paginatorNotice(fmt.Sprintf("Enter a page between 1 and %d.", paginator.PageCount)), nil
+		}
+
+		page = number - 1
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownComponent, args.CustomID)
+	}
+
+	paginator.page = max(0, min(page, paginator.PageCount-1))
+
+	err := paginator.render(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator.lastInteraction = interaction
+	paginator.lastReceivedAt = time.Now()
+	paginator.timer.Reset(paginator.Timeout)
+
+	return &InteractionResponse{
+		Type: InteractionCallbackTypeUpdateMessage,
+		Data: &InteractionCallbackData{
+			Embeds:     paginator.embeds(),
+			Components: paginator.components(false),
+		},
+	}, nil
+}
+
+// Stop stops a paginator and disables its buttons.
+func (pm *PaginatorManager) Stop(paginator *Paginator) {
+	if paginator.timer != nil {
+		paginator.timer.Stop()
+	}
+
+	pm.expire(paginator)
+}
+
+// expire removes a paginator and disables its buttons. The message is edited through the most
+// recent interaction while its token is valid, otherwise through the channel message.
+func (pm *PaginatorManager) expire(paginator *Paginator) {
+	pm.mu.Lock()
+	delete(pm.paginators, paginator.id)
+	pm.mu.Unlock()
+
+	paginator.mu.Lock()
+	defer paginator.mu.Unlock()
+
+	if paginator.expired {
+		return
+	}
+
+	paginator.expired = true
+
+	ctx := context.Background()
+
+	if paginator.lastInteraction != nil && time.Since(paginator.lastReceivedAt) < InteractionTokenLifetime {
+		_, _ = EditOriginalInteractionResponse(ctx, pm.Session, paginator.lastInteraction.ApplicationID, paginator.lastInteraction.Token, WebhookMessageParams{
+			Embeds:     paginator.embeds(),
+			Components: paginator.components(true),
+		})
+
+		return
+	}
+
+	if !paginator.messageID.IsNil() {
+		_, _ = EditMessage(ctx, pm.Session, paginator.channelID, paginator.messageID, MessageParams{
+			Embeds:     paginator.embeds(),
+			Components: paginator.components(true),
+		})
+	}
+}
+
+// paginatorNotice returns an ephemeral message shown to the user who pressed a button.
+func paginatorNotice(content string) *InteractionResponse {
+	return &InteractionResponse{
+		Type: InteractionCallbackTypeChannelMessageSource,
+		Data: &InteractionCallbackData{
+			Content: content,
+			Flags:   uint32(MessageFlagEphemeral),
+		},
+	}
+}
@@ -23,15 +23,15 @@ type Role struct {
 	Mentionable  bool        `json:"mentionable"`
 }
 
-// RoleParams represents the structure used to create a role.
+// RoleParams represents the structure used to create or modify a role.
 type RoleParams struct {
-	Name         *string `json:"name,omitempty"`
-	Permissions  *Int64  `json:"permissions,omitempty"`
-	Color        *int32  `json:"color,omitempty"`
-	Hoist        *bool   `json:"hoist,omitempty"`
-	Icon         *string `json:"icon,omitempty"`
-	UnicodeEmoji *string `json:"unicode_emoji,omitempty"`
-	Mentionable  *bool   `json:"mentionable,omitempty"`
+	Name         Optional[string] `json:"name,omitempty"`
+	Permissions  Optional[Int64]  `json:"permissions,omitempty"`
+	Color        Optional[int32]  `json:"color,omitempty"`
+	Hoist        Optional[bool]   `json:"hoist,omitempty"`
+	Icon         Nullable[string] `json:"icon,omitempty"`
+	UnicodeEmoji Nullable[string] `json:"unicode_emoji,omitempty"`
+	Mentionable  Optional[bool]   `json:"mentionable,omitempty"`
 }
 
 // Delete deletes a guild role.
@@ -43,7 +43,7 @@ func (r *Role) Delete(ctx context.Context, session *Session, reason *string) err
 // Edit edits a guild role.
 // params: The role parameters to update the role with.
 // reason: Reason for editing a guild role.
-func (r *Role) Edit(ctx context.Context, session *Session, params Role, reason *string) error {
+func (r *Role) Edit(ctx context.Context, session *Session, params RoleParams, reason *string) error {
 	newRole, err := ModifyGuildRole(ctx, session, *r.GuildID, r.ID, params, reason)
 	if err != nil {
 		return err
@@ -0,0 +1,290 @@
+package discord
+
+import (
+	"slices"
+	"time"
+)
+
+// scheduled_event.go contains the parameters for guild scheduled events and an expander for
+// their recurrence rules.
+
+// RecurrenceFrequency represents how often a scheduled event repeats.
+type RecurrenceFrequency uint8
+
+const (
+	RecurrenceFrequencyYearly RecurrenceFrequency = iota
+	RecurrenceFrequencyMonthly
+	RecurrenceFrequencyWeekly
+	RecurrenceFrequencyDaily
+)
+
+// RecurrenceWeekday represents a day of the week in a recurrence rule. Unlike time.Weekday,
+// weeks start on Monday.
+type RecurrenceWeekday uint8
+
+const (
+	RecurrenceWeekdayMonday RecurrenceWeekday = iota
+	RecurrenceWeekdayTuesday
+	RecurrenceWeekdayWednesday
+	RecurrenceWeekdayThursday
+	RecurrenceWeekdayFriday
+	RecurrenceWeekdaySaturday
+	RecurrenceWeekdaySunday
+)
+
+// RecurrenceWeekdayOf returns the recurrence weekday of a time.Weekday.
+func RecurrenceWeekdayOf(weekday time.Weekday) RecurrenceWeekday {
+	return RecurrenceWeekday((int(weekday) + 6) % 7)
+}
+
+// RecurrenceMonth represents a month in a recurrence rule.
+type RecurrenceMonth uint8
+
+const (
+	RecurrenceMonthJanuary RecurrenceMonth = 1 + iota
+	RecurrenceMonthFebruary
+	RecurrenceMonthMarch
+	RecurrenceMonthApril
+	RecurrenceMonthMay
+	RecurrenceMonthJune
+	RecurrenceMonthJuly
+	RecurrenceMonthAugust
+	RecurrenceMonthSeptember
+	RecurrenceMonthOctober
+	RecurrenceMonthNovember
+	RecurrenceMonthDecember
+)
+
+// RecurrenceNWeekday represents a specific day within a week of the month, such as the
+// second Tuesday.
+type RecurrenceNWeekday struct {
+	// N is the week of the month, from 1 to 5.
+	N   int32             `json:"n"`
+	Day RecurrenceWeekday `json:"day"`
+}
+
+// ScheduledEventRecurrenceRule represents how a scheduled event repeats.
+type ScheduledEventRecurrenceRule struct {
+	Start time.Time `json:"start"`
+
+	// End is when the recurrence stops. This is read only.
+	End *time.Time `json:"end,omitempty"`
+
+	// Count is how many times the event occurs. This is read only.
+	Count *int32 `json:"count,omitempty"`
+
+	ByWeekday  []RecurrenceWeekday  `json:"by_weekday,omitempty"`
+	ByNWeekday []RecurrenceNWeekday `json:"by_n_weekday,omitempty"`
+	ByMonth    []RecurrenceMonth    `json:"by_month,omitempty"`
+	ByMonthDay []int32              `json:"by_month_day,omitempty"`
+
+	// ByYearDay is the days of the year the event occurs on. This is read only.
+	ByYearDay []int32 `json:"by_year_day,omitempty"`
+
+	// Interval is the spacing between occurrences, such as 2 with a weekly frequency for every other week.
+	Interval  int32               `json:"interval"`
+	Frequency RecurrenceFrequency `json:"frequency"`
+}
+
+// Next returns up to n occurrences of the rule that start after the given time. Each
+// occurrence keeps the time of day of Start, in Start's location. Fewer than n are returned if
+// the rule ends, by End or Count, or can never match again.
+func (rr *ScheduledEventRecurrenceRule) Next(after time.Time, n int) []time.Time {
+	occurrences := make([]time.Time, 0, n)
+
+	if n <= 0 {
+		return occurrences
+	}
+
+	start := rr.Start
+	year, month, day := start.Date()
+	count := int32(0)
+
+	// Searching is bounded from the later of the start and the given time, so rules that started
+	// long ago do not need to be walked from the beginning unless they have a count.
+	searchFrom := 0
+	if rr.Count == nil && after.After(start) {
+		searchFrom = int(after.Sub(start).Hours()/24) - 1
+	}
+
+	// The search ends once no occurrence has been found for longer than the rule's longest gap
+	// between occurrences, so rules that can never match do not loop forever.
+	maxGap := rr.maxGapDays()
+	lastMatch := max(searchFrom, 0)
+
+	for offset := lastMatch; offset-lastMatch <= maxGap; offset++ {
+		candidate := time.Date(year, month, day+offset, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+
+		if rr.End != nil && candidate.After(*rr.End) {
+			break
+		}
+
+		if !rr.matches(candidate) {
+			continue
+		}
+
+		lastMatch = offset
+		count++
+
+		if rr.Count != nil && count > *rr.Count {
+			break
+		}
+
+		if candidate.After(after) {
+			occurrences = append(occurrences, candidate)
+
+			if len(occurrences) == n {
+				break
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// maxGapDays returns the most days there can be between two occurrences of the rule. This allows
+// for weekday filters on daily rules, months without a given day or weekday, and yearly rules on
+// the 29th of February, which may skip a leap year at the turn of a century.
+func (rr *ScheduledEventRecurrenceRule) maxGapDays() int {
+	interval := int(max(rr.Interval, 1))
+
+	switch rr.Frequency {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly:
+		return 7 * interval * 2
+	case RecurrenceFrequencyMonthly:
+		return 31 * interval * 12
+	default:
+		return 366 * interval * 8
+	}
+}
+
+// matches returns if a day is an occurrence of the rule. The candidate must not be before Start.
+func (rr *ScheduledEventRecurrenceRule) matches(candidate time.Time) bool {
+	start := rr.Start
+	interval := int(max(rr.Interval, 1))
+	weekday := RecurrenceWeekdayOf(candidate.Weekday())
+
+	switch rr.Frequency {
+	case RecurrenceFrequencyDaily:
+		if daysBetween(start, candidate)%interval != 0 {
+			return false
+		}
+
+		return len(rr.ByWeekday) == 0 || slices.Contains(rr.ByWeekday, weekday)
+	case RecurrenceFrequencyWeekly:
+		weeks := daysBetween(startOfWeek(start), startOfWeek(candidate)) / 7
+		if weeks%interval != 0 {
+			return false
+		}
+
+		if len(rr.ByWeekday) == 0 {
+			return weekday == RecurrenceWeekdayOf(start.Weekday())
+		}
+
+		return slices.Contains(rr.ByWeekday, weekday)
+	case RecurrenceFrequencyMonthly:
+		months := (candidate.Year()-start.Year())*12 + int(candidate.Month()) - int(start.Month())
+		if months%interval != 0 {
+			return false
+		}
+
+		return rr.matchesDayOfMonth(candidate, start.Day())
+	case RecurrenceFrequencyYearly:
+		if (candidate.Year()-start.Year())%interval != 0 {
+			return false
+		}
+
+		if len(rr.ByMonth) > 0 {
+			if !slices.Contains(rr.ByMonth, RecurrenceMonth(candidate.Month())) {
+				return false
+			}
+		} else if len(rr.ByYearDay) == 0 && candidate.Month() != start.Month() {
+			return false
+		}
+
+		if len(rr.ByYearDay) > 0 {
+			return slices.Contains(rr.ByYearDay, int32(candidate.YearDay()))
+		}
+
+		return rr.matchesDayOfMonth(candidate, start.Day())
+	default:
+		return false
+	}
+}
+
+// matchesDayOfMonth returns if the candidate falls on the rule's day of the month, defaulting
+// to the day of the month of Start.
+func (rr *ScheduledEventRecurrenceRule) matchesDayOfMonth(candidate time.Time, startDay int) bool {
+	if len(rr.ByNWeekday) > 0 {
+		week := int32((candidate.Day()-1)/7 + 1)
+		weekday := RecurrenceWeekdayOf(candidate.Weekday())
+
+		return slices.ContainsFunc(rr.ByNWeekday, func(nWeekday RecurrenceNWeekday) bool {
+			return nWeekday.N == week && nWeekday.Day == weekday
+		})
+	}
+
+	if len(rr.ByMonthDay) > 0 {
+		return slices.Contains(rr.ByMonthDay, int32(candidate.Day()))
+	}
+
+	return candidate.Day() == startDay
+}
+
+// daysBetween returns the number of calendar days from a to b, ignoring the time of day.
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+
+	return int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
+
+// startOfWeek returns the Monday of the week containing t.
+func startOfWeek(t time.Time) time.Time {
+	return t.AddDate(0, 0, -int(RecurrenceWeekdayOf(t.Weekday())))
+}
+
+// NextOccurrences returns up to n upcoming start times of the event after the given time.
+// Events without a recurrence rule have a single occurrence at their scheduled start time.
+func (se *ScheduledEvent) NextOccurrences(after time.Time, n int) []time.Time {
+	if se.RecurrenceRule == nil {
+		if n > 0 && se.ScheduledStartTime.After(after) {
+			return []time.Time{se.ScheduledStartTime}
+		}
+
+		return []time.Time{}
+	}
+
+	return se.RecurrenceRule.Next(after, n)
+}
+
+// CreateScheduledEventParams represents the parameters for creating a guild scheduled event.
+type CreateScheduledEventParams struct {
+	ScheduledStartTime time.Time                     `json:"scheduled_start_time"`
+	ScheduledEndTime   *time.Time                    `json:"scheduled_end_time,omitempty"`
+	ChannelID          *Snowflake                    `json:"channel_id,omitempty"`
+	EntityMetadata     *EventMetadata                `json:"entity_metadata,omitempty"`
+	Image              *string                       `json:"image,omitempty"`
+	RecurrenceRule     *ScheduledEventRecurrenceRule `json:"recurrence_rule,omitempty"`
+	Name               string                        `json:"name"`
+	Description        string                        `json:"description,omitempty"`
+	PrivacyLevel       StageChannelPrivacyLevel      `json:"privacy_level"`
+	EntityType         ScheduledEntityType           `json:"entity_type"`
+}
+
+// ModifyScheduledEventParams represents the parameters for modifying a guild scheduled event.
+// Setting Status starts, completes or cancels the event. ChannelID must be set to null when
+// changing the entity type to external.
+type ModifyScheduledEventParams struct {
+	ScheduledStartTime Optional[time.Time]                    `json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime   Optional[time.Time]                    `json:"scheduled_end_time,omitempty"`
+	ChannelID          Nullable[Snowflake]                    `json:"channel_id,omitempty"`
+	EntityMetadata     Nullable[EventMetadata]                `json:"entity_metadata,omitempty"`
+	Name               Optional[string]                       `json:"name,omitempty"`
+	Description        Nullable[string]                       `json:"description,omitempty"`
+	Image              Optional[string]                       `json:"image,omitempty"`
+	RecurrenceRule     Nullable[ScheduledEventRecurrenceRule] `json:"recurrence_rule,omitempty"`
+	PrivacyLevel       Optional[StageChannelPrivacyLevel]     `json:"privacy_level,omitempty"`
+	EntityType         Optional[ScheduledEntityType]          `json:"entity_type,omitempty"`
+	Status             Optional[EventStatus]                  `json:"status,omitempty"`
+}
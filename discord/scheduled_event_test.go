@@ -0,0 +1,138 @@
+package discord
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduledEventRecurrenceRuleNext(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 18, 30, 0, 0, time.UTC)
+	}
+
+	int32Pointer := func(value int32) *int32 { return &value }
+	timePointer := func(value time.Time) *time.Time { return &value }
+
+	tests := []struct {
+		name  string
+		rule  ScheduledEventRecurrenceRule
+		after time.Time
+		n     int
+		want  []time.Time
+	}{
+		{
+			name:  "daily",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2024, time.January, 1), Frequency: RecurrenceFrequencyDaily, Interval: 1},
+			after: date(2023, time.December, 31),
+			n:     3,
+			want:  []time.Time{date(2024, time.January, 1), date(2024, time.January, 2), date(2024, time.January, 3)},
+		},
+		{
+			name:  "daily with interval from a later time",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2024, time.January, 1), Frequency: RecurrenceFrequencyDaily, Interval: 2},
+			after: date(2024, time.January, 10),
+			n:     2,
+			want:  []time.Time{date(2024, time.January, 11), date(2024, time.January, 13)},
+		},
+		{
+			name: "every other week on weekdays",
+			rule: ScheduledEventRecurrenceRule{
+				Start:     date(2024, time.January, 1),
+				Frequency: RecurrenceFrequencyWeekly,
+				Interval:  2,
+				ByWeekday: []RecurrenceWeekday{RecurrenceWeekdayMonday, RecurrenceWeekdayWednesday},
+			},
+			after: date(2023, time.December, 31),
+			n:     4,
+			want:  []time.Time{date(2024, time.January, 1), date(2024, time.January, 3), date(2024, time.January, 15), date(2024, time.January, 17)},
+		},
+		{
+			name: "second tuesday of the month",
+			rule: ScheduledEventRecurrenceRule{
+				Start:      date(2024, time.January, 9),
+				Frequency:  RecurrenceFrequencyMonthly,
+				Interval:   1,
+				ByNWeekday: []RecurrenceNWeekday{{N: 2, Day: RecurrenceWeekdayTuesday}},
+			},
+			after: date(2024, time.January, 1),
+			n:     3,
+			want:  []time.Time{date(2024, time.January, 9), date(2024, time.February, 13), date(2024, time.March, 12)},
+		},
+		{
+			name:  "monthly on the 31st skips short months",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2024, time.January, 31), Frequency: RecurrenceFrequencyMonthly, Interval: 1},
+			after: date(2024, time.January, 31),
+			n:     2,
+			want:  []time.Time{date(2024, time.March, 31), date(2024, time.May, 31)},
+		},
+		{
+			name:  "yearly on the 29th of february",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2024, time.February, 29), Frequency: RecurrenceFrequencyYearly, Interval: 1},
+			after: date(2024, time.February, 29),
+			n:     2,
+			want:  []time.Time{date(2028, time.February, 29), date(2032, time.February, 29)},
+		},
+		{
+			name:  "yearly on the 29th of february across a century",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2096, time.February, 29), Frequency: RecurrenceFrequencyYearly, Interval: 1},
+			after: date(2096, time.February, 29),
+			n:     1,
+			want:  []time.Time{date(2104, time.February, 29)},
+		},
+		{
+			name:  "yearly with interval",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2024, time.June, 15), Frequency: RecurrenceFrequencyYearly, Interval: 2},
+			after: date(2024, time.January, 1),
+			n:     3,
+			want:  []time.Time{date(2024, time.June, 15), date(2026, time.June, 15), date(2028, time.June, 15)},
+		},
+		{
+			name:  "stops at count",
+			rule:  ScheduledEventRecurrenceRule{Start: date(2024, time.January, 1), Frequency: RecurrenceFrequencyDaily, Interval: 1, Count: int32Pointer(3)},
+			after: date(2024, time.January, 1),
+			n:     5,
+			want:  []time.Time{date(2024, time.January, 2), date(2024, time.January, 3)},
+		},
+		{
+			name: "stops at end",
+			rule: ScheduledEventRecurrenceRule{
+				Start:     date(2024, time.January, 1),
+				Frequency: RecurrenceFrequencyDaily,
+				Interval:  1,
+				End:       timePointer(date(2024, time.January, 2).Add(time.Hour)),
+			},
+			after: date(2023, time.December, 31),
+			n:     5,
+			want:  []time.Time{date(2024, time.January, 1), date(2024, time.January, 2)},
+		},
+		{
+			name: "never matches",
+			rule: ScheduledEventRecurrenceRule{
+				Start:      date(2024, time.February, 1),
+				Frequency:  RecurrenceFrequencyYearly,
+				Interval:   1,
+				ByMonth:    []RecurrenceMonth{RecurrenceMonthFebruary},
+				ByMonthDay: []int32{30},
+			},
+			after: date(2024, time.January, 1),
+			n:     1,
+			want:  []time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Next(tt.after, tt.n)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Next() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Fatalf("Next() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -30,7 +31,13 @@ type RESTInterface interface {
 // Session contains the context for the discord rest interface.
 type Session struct {
 	Interface RESTInterface
-	Token     string
+
+	// TokenSource provides the Authorization header. If set, it is used instead of Token and is
+	// refreshed if a request is rejected.
+	TokenSource TokenSource
+
+	// Token is sent as the Authorization header verbatim, so bot tokens must include the Bot prefix.
+	Token string
 }
 
 func NewSession(token string, httpInterface RESTInterface) *Session {
@@ -40,6 +47,64 @@ func NewSession(token string, httpInterface RESTInterface) *Session {
 	}
 }
 
+// NewBotSession creates a session for a bot token, adding the Bot prefix if it is missing.
+func NewBotSession(token string, httpInterface RESTInterface) *Session {
+	return NewTokenSession(BotToken(token), httpInterface)
+}
+
+// NewTokenSession creates a session authorized by a token source.
+func NewTokenSession(tokenSource TokenSource, httpInterface RESTInterface) *Session {
+	return &Session{
+		TokenSource: tokenSource,
+		Interface:   httpInterface,
+	}
+}
+
+// Authorization returns the value of the Authorization header for the session.
+func (s *Session) Authorization(ctx context.Context) (string, error) {
+	if s.TokenSource == nil {
+		return s.Token, nil
+	}
+
+	authorization, err := s.TokenSource.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return authorization, nil
+}
+
+// fetchAuthorized calls fetch with the session's Authorization header. If the request is
+// unauthorized and the token source can be refreshed, it is refreshed and the request is retried once.
+func (s *Session) fetchAuthorized(ctx context.Context, fetch func(authorization string) ([]byte, error)) ([]byte, error) {
+	authorization, err := s.Authorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := fetch(authorization)
+	if !errors.Is(err, ErrUnauthorized) {
+		return response, err
+	}
+
+	tokenSource, ok := s.TokenSource.(RefreshableTokenSource)
+	if !ok {
+		return response, err
+	}
+
+	err = tokenSource.Refresh(ctx, authorization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	authorization, err = s.Authorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetch(authorization)
+}
+
 // BaseInterface is the default HTTP Interface and simply handles routing to discord. Careful,
 // this does not handle rate limiting.
 type BaseInterface struct {
@@ -75,6 +140,12 @@ func NewInterface(httpClient *http.Client, endpoint, version, useragent string)
 }
 
 func (bi *BaseInterface) Fetch(ctx context.Context, session *Session, method, endpoint, contentType string, body []byte, headers http.Header) ([]byte, error) {
+	return session.fetchAuthorized(ctx, func(authorization string) ([]byte, error) {
+		return bi.fetch(ctx, method, endpoint, contentType, body, headers, authorization)
+	})
+}
+
+func (bi *BaseInterface) fetch(ctx context.Context, method, endpoint, contentType string, body []byte, headers http.Header, authorization string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new request: %w", err)
@@ -102,8 +173,8 @@ func (bi *BaseInterface) Fetch(ctx context.Context, session *Session, method, en
 		req.Header.Set("Content-Type", contentType)
 	}
 
-	if session.Token != "" {
-		req.Header.Set("Authorization", session.Token)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
 	}
 
 	req.Header.Set("Accept", "application/json")
@@ -200,6 +271,12 @@ func NewTwilightProxy(url url.URL) TwilightProxy {
 }
 
 func (tl *TwilightProxy) Fetch(ctx context.Context, session *Session, method, endpoint, contentType string, body []byte, headers http.Header) ([]byte, error) {
+	return session.fetchAuthorized(ctx, func(authorization string) ([]byte, error) {
+		return tl.fetch(ctx, method, endpoint, contentType, body, headers, authorization)
+	})
+}
+
+func (tl *TwilightProxy) fetch(ctx context.Context, method, endpoint, contentType string, body []byte, headers http.Header, authorization string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new request: %w", err)
@@ -227,8 +304,8 @@ func (tl *TwilightProxy) Fetch(ctx context.Context, session *Session, method, en
 		req.Header.Set("Content-Type", contentType)
 	}
 
-	if session.Token != "" {
-		req.Header.Set("Authorization", session.Token)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
 	}
 
 	req.Header.Set("Accept", "application/json")
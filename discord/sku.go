@@ -0,0 +1,98 @@
+package discord
+
+import "time"
+
+// sku.go contains the structures for SKUs and subscriptions, used for app monetization.
+
+// SKUType represents the type of a SKU.
+type SKUType uint16
+
+const (
+	// SKUTypeDurable is a one-time purchase that is permanent.
+	SKUTypeDurable SKUType = 2
+	// SKUTypeConsumable is a one-time purchase that can be consumed.
+	SKUTypeConsumable SKUType = 3
+	// SKUTypeSubscription represents a recurring subscription.
+	SKUTypeSubscription SKUType = 5
+	// SKUTypeSubscriptionGroup is a system-generated group for each subscription SKU.
+	SKUTypeSubscriptionGroup SKUType = 6
+)
+
+// SKUFlags represents the flags of a SKU.
+type SKUFlags uint32
+
+const (
+	SKUFlagsAvailable         SKUFlags = 1 << 2
+	SKUFlagsGuildSubscription SKUFlags = 1 << 7
+	SKUFlagsUserSubscription  SKUFlags = 1 << 8
+)
+
+// SKUAccessType represents the access a SKU grants. This is undocumented, but present in the API.
+type SKUAccessType uint16
+
+const (
+	SKUAccessTypeFull SKUAccessType = 1 + iota
+	SKUAccessTypeEarlyAccess
+	SKUAccessTypeVIPAccess
+)
+
+// SKU represents a premium offering that can be made available to an application's users or guilds.
+type SKU struct {
+	DependentSKUID *Snowflake    `json:"dependent_sku_id,omitempty"`
+	ReleaseDate    *time.Time    `json:"release_date,omitempty"`
+	Name           string        `json:"name"`
+	Slug           string        `json:"slug"`
+	Features       StringList    `json:"features,omitempty"`
+	ID             Snowflake     `json:"id"`
+	ApplicationID  Snowflake     `json:"application_id"`
+	Flags          SKUFlags      `json:"flags"`
+	Type           SKUType       `json:"type"`
+	AccessType     SKUAccessType `json:"access_type,omitempty"`
+}
+
+// IsAvailable returns if the SKU can be purchased.
+func (s *SKU) IsAvailable() bool {
+	return s.Flags&SKUFlagsAvailable != 0
+}
+
+// IsGuildSubscription returns if the SKU is a subscription purchased by a user and applied to a guild.
+func (s *SKU) IsGuildSubscription() bool {
+	return s.Flags&SKUFlagsGuildSubscription != 0
+}
+
+// IsUserSubscription returns if the SKU is a subscription purchased by a user for themselves.
+func (s *SKU) IsUserSubscription() bool {
+	return s.Flags&SKUFlagsUserSubscription != 0
+}
+
+// SubscriptionStatus represents the status of a subscription.
+type SubscriptionStatus uint16
+
+const (
+	// SubscriptionStatusActive is a subscription that is active and scheduled to renew.
+	SubscriptionStatusActive SubscriptionStatus = iota
+	// SubscriptionStatusEnding is a subscription that is active but will not renew.
+	SubscriptionStatusEnding
+	// SubscriptionStatusInactive is a subscription that is no longer active.
+	SubscriptionStatusInactive
+)
+
+// Subscription represents a user making recurring payments for at least one SKU.
+type Subscription struct {
+	CurrentPeriodStart time.Time          `json:"current_period_start"`
+	CurrentPeriodEnd   time.Time          `json:"current_period_end"`
+	CanceledAt         *time.Time         `json:"canceled_at,omitempty"`
+	Country            *string            `json:"country,omitempty"`
+	SKUIDs             []Snowflake        `json:"sku_ids"`
+	EntitlementIDs     []Snowflake        `json:"entitlement_ids"`
+	RenewalSKUIDs      []Snowflake        `json:"renewal_sku_ids,omitempty"`
+	ID                 Snowflake          `json:"id"`
+	UserID             Snowflake          `json:"user_id"`
+	Status             SubscriptionStatus `json:"status"`
+}
+
+// IsActive returns if the subscription grants access at the given time. Ending subscriptions
+// remain active until the end of the current period.
+func (s *Subscription) IsActive(now time.Time) bool {
+	return s.Status != SubscriptionStatusInactive && now.Before(s.CurrentPeriodEnd)
+}
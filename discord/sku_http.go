@@ -0,0 +1,74 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListSKUs returns the SKUs of an application.
+func ListSKUs(ctx context.Context, session *Session, applicationID Snowflake) ([]SKU, error) {
+	endpoint := EndpointApplicationSKUs(applicationID.String())
+
+	var skus []SKU
+
+	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &skus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list skus: %w", err)
+	}
+
+	return skus, nil
+}
+
+// ListSKUSubscriptions returns the subscriptions to a SKU.
+// userID: The user to return subscriptions for. Required unless using an OAuth2 bearer token.
+func ListSKUSubscriptions(ctx context.Context, session *Session, skuID Snowflake, before, after *Snowflake, limit *int32, userID *Snowflake) ([]Subscription, error) {
+	endpoint := EndpointSKUSubscriptions(skuID.String())
+
+	values := url.Values{}
+
+	if before != nil {
+		values.Set("before", before.String())
+	}
+
+	if after != nil {
+		values.Set("after", after.String())
+	}
+
+	if limit != nil {
+		values.Set("limit", strconv.FormatInt(int64(*limit), 10))
+	}
+
+	if userID != nil {
+		values.Set("user_id", userID.String())
+	}
+
+	if len(values) > 0 {
+		endpoint += "?" + values.Encode()
+	}
+
+	var subscriptions []Subscription
+
+	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sku subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// GetSKUSubscription returns a subscription to a SKU.
+func GetSKUSubscription(ctx context.Context, session *Session, skuID, subscriptionID Snowflake) (*Subscription, error) {
+	endpoint := EndpointSKUSubscription(skuID.String(), subscriptionID.String())
+
+	var subscription *Subscription
+
+	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sku subscription: %w", err)
+	}
+
+	return subscription, nil
+}
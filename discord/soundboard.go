@@ -31,8 +31,8 @@ type CreateSoundboardSoundParams struct {
 
 // ModifySoundboardSoundParams represents the payload for modifying a soundboard sound.
 type ModifySoundboardSoundParams struct {
-	Name      *string    `json:"name,omitempty"`
-	Volume    *float64   `json:"volume,omitempty"`
-	EmojiID   *Snowflake `json:"emoji_id,omitempty"`
-	EmojiName *string    `json:"emoji_name,omitempty"`
+	Name      Optional[string]    `json:"name,omitempty"`
+	Volume    Nullable[float64]   `json:"volume,omitempty"`
+	EmojiID   Nullable[Snowflake] `json:"emoji_id,omitempty"`
+	EmojiName Nullable[string]    `json:"emoji_name,omitempty"`
 }
@@ -16,9 +16,9 @@ type StickerParams struct {
 
 // ModifyStickerParams represents the parameters for modifying a sticker.
 type ModifyStickerParams struct {
-	Name        *string `json:"name,omitempty"`
-	Tags        *string `json:"tags,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Name        Optional[string] `json:"name,omitempty"`
+	Tags        Optional[string] `json:"tags,omitempty"`
+	Description Nullable[string] `json:"description,omitempty"`
 }
 
 func ListNitroStickerPacks(ctx context.Context, session *Session) ([]any, error) {
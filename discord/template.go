@@ -1,3 +1,190 @@
 package discord
 
+import (
+	"slices"
+	"time"
+)
+
 // template.go represents all structures relating to guild templates.
+
+// GuildTemplate represents a guild template.
+type GuildTemplate struct {
+	CreatedAt             time.Time             `json:"created_at"`
+	UpdatedAt             time.Time             `json:"updated_at"`
+	Creator               *User                 `json:"creator,omitempty"`
+	Description           *string               `json:"description,omitempty"`
+	IsDirty               *bool                 `json:"is_dirty,omitempty"`
+	Code                  string                `json:"code"`
+	Name                  string                `json:"name"`
+	SerializedSourceGuild GuildTemplateSnapshot `json:"serialized_source_guild"`
+	CreatorID             Snowflake             `json:"creator_id"`
+	SourceGuildID         Snowflake             `json:"source_guild_id"`
+	UsageCount            int32                 `json:"usage_count"`
+}
+
+// GuildTemplateSnapshot represents the guild a template was created from, at the time it was
+// last synced. Roles and channels use template-local IDs rather than snowflakes, with the
+// @everyone role having an ID of 0. Channel parents and permission overwrites reference these
+// local IDs.
+type GuildTemplateSnapshot struct {
+	AFKChannelID                *Snowflake                 `json:"afk_channel_id,omitempty"`
+	SystemChannelID             *Snowflake                 `json:"system_channel_id,omitempty"`
+	Description                 *string                    `json:"description,omitempty"`
+	IconHash                    *string                    `json:"icon_hash,omitempty"`
+	Name                        string                     `json:"name"`
+	Region                      string                     `json:"region,omitempty"`
+	PreferredLocale             string                     `json:"preferred_locale"`
+	Roles                       []Role                     `json:"roles"`
+	Channels                    []Channel                  `json:"channels"`
+	AFKTimeout                  int32                      `json:"afk_timeout"`
+	SystemChannelFlags          SystemChannelFlags         `json:"system_channel_flags"`
+	VerificationLevel           VerificationLevel          `json:"verification_level"`
+	DefaultMessageNotifications MessageNotificationLevel   `json:"default_message_notifications"`
+	ExplicitContentFilter       ExplicitContentFilterLevel `json:"explicit_content_filter"`
+}
+
+// GuildTemplateOverwrite represents a permission overwrite in a template with its role resolved.
+type GuildTemplateOverwrite struct {
+	Role  *Role `json:"role"`
+	Allow Int64 `json:"allow"`
+	Deny  Int64 `json:"deny"`
+}
+
+// GuildTemplateChannelPreview represents a channel a template will create, with its overwrites
+// resolved. Categories include the channels that will be created within them.
+type GuildTemplateChannelPreview struct {
+	Channel    *Channel                      `json:"channel"`
+	Overwrites []GuildTemplateOverwrite      `json:"overwrites"`
+	Children   []GuildTemplateChannelPreview `json:"children,omitempty"`
+}
+
+// CreateGuildFromTemplateParams represents the parameters for creating a guild from a template.
+type CreateGuildFromTemplateParams struct {
+	Icon *string `json:"icon,omitempty"`
+	Name string  `json:"name"`
+}
+
+// CreateGuildTemplateParams represents the parameters for creating a guild template.
+type CreateGuildTemplateParams struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// ModifyGuildTemplateParams represents the parameters for modifying a guild template.
+type ModifyGuildTemplateParams struct {
+	Name        Optional[string] `json:"name,omitempty"`
+	Description Nullable[string] `json:"description,omitempty"`
+}
+
+// EveryoneRole returns the @everyone role of the template.
+func (gts *GuildTemplateSnapshot) EveryoneRole() *Role {
+	return gts.Role(0)
+}
+
+// Role returns the role with a template-local ID, or nil if there is none.
+func (gts *GuildTemplateSnapshot) Role(localID Snowflake) *Role {
+	for i := range gts.Roles {
+		if gts.Roles[i].ID == localID {
+			return &gts.Roles[i]
+		}
+	}
+
+	return nil
+}
+
+// Channel returns the channel with a template-local ID, or nil if there is none.
+func (gts *GuildTemplateSnapshot) Channel(localID Snowflake) *Channel {
+	for i := range gts.Channels {
+		if gts.Channels[i].ID == localID {
+			return &gts.Channels[i]
+		}
+	}
+
+	return nil
+}
+
+// Parent returns the category of a channel in the template, or nil if it has none.
+func (gts *GuildTemplateSnapshot) Parent(channel *Channel) *Channel {
+	if channel.ParentID == nil {
+		return nil
+	}
+
+	return gts.Channel(*channel.ParentID)
+}
+
+// Overwrites returns the permission overwrites of a channel in the template with their roles
+// resolved. Overwrites referencing roles missing from the template are skipped.
+func (gts *GuildTemplateSnapshot) Overwrites(channel *Channel) []GuildTemplateOverwrite {
+	overwrites := make([]GuildTemplateOverwrite, 0, len(channel.PermissionOverwrites))
+
+	for _, overwrite := range channel.PermissionOverwrites {
+		if overwrite.Type != ChannelOverrideTypeRole {
+			continue
+		}
+
+		role := gts.Role(overwrite.ID)
+		if role == nil {
+			continue
+		}
+
+		overwrites = append(overwrites, GuildTemplateOverwrite{
+			Role:  role,
+			Allow: overwrite.Allow,
+			Deny:  overwrite.Deny,
+		})
+	}
+
+	return overwrites
+}
+
+// Preview returns the channels the template will create, in the order they appear in the
+// channel list. Channels without a category are listed first, followed by each category with
+// its channels nested under it.
+func (gts *GuildTemplateSnapshot) Preview() []GuildTemplateChannelPreview {
+	channels := make([]*Channel, len(gts.Channels))
+	for i := range gts.Channels {
+		channels[i] = &gts.Channels[i]
+	}
+
+	slices.SortStableFunc(channels, func(a, b *Channel) int {
+		return int(a.Position) - int(b.Position)
+	})
+
+	var uncategorized []GuildTemplateChannelPreview
+
+	categories := make([]GuildTemplateChannelPreview, 0)
+	categoryIndex := make(map[Snowflake]int)
+
+	for _, channel := range channels {
+		if channel.Type == ChannelTypeGuildCategory {
+			categoryIndex[channel.ID] = len(categories)
+			categories = append(categories, gts.previewChannel(channel))
+		}
+	}
+
+	for _, channel := range channels {
+		if channel.Type == ChannelTypeGuildCategory {
+			continue
+		}
+
+		// Channels whose category is missing from the template are treated as uncategorized.
+		if channel.ParentID != nil {
+			if index, ok := categoryIndex[*channel.ParentID]; ok {
+				categories[index].Children = append(categories[index].Children, gts.previewChannel(channel))
+
+				continue
+			}
+		}
+
+		uncategorized = append(uncategorized, gts.previewChannel(channel))
+	}
+
+	return append(uncategorized, categories...)
+}
+
+func (gts *GuildTemplateSnapshot) previewChannel(channel *Channel) GuildTemplateChannelPreview {
+	return GuildTemplateChannelPreview{
+		Channel:    channel,
+		Overwrites: gts.Overwrites(channel),
+	}
+}
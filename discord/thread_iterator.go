@@ -0,0 +1,160 @@
+package discord
+
+import (
+	"context"
+	"time"
+)
+
+// thread_iterator.go contains an iterator for paging through archived threads.
+
+// ArchivedThreadType represents which archived threads are listed.
+type ArchivedThreadType uint8
+
+const (
+	ArchivedThreadTypePublic ArchivedThreadType = iota
+	ArchivedThreadTypePrivate
+	ArchivedThreadTypeJoinedPrivate
+)
+
+// ArchivedThreadIterator walks every archived thread in a channel, requesting further pages
+// while the response has more threads.
+//
+//	iterator := NewArchivedThreadIterator(session, channelID, ArchivedThreadTypePublic, nil)
+//	for iterator.Next(ctx) {
+//		thread := iterator.Thread()
+//	}
+//
+//	if err := iterator.Err(); err != nil {
+//		...
+//	}
+type ArchivedThreadIterator struct {
+	Session   *Session
+	Limit     *int32
+	err       error
+	before    *time.Time
+	beforeID  *Snowflake
+	threads   []Channel
+	members   map[Snowflake]*ThreadMember
+	index     int
+	ChannelID Snowflake
+	Type      ArchivedThreadType
+	hasMore   bool
+}
+
+// NewArchivedThreadIterator creates an iterator over the archived threads of a channel.
+// limit: Maximum number of threads requested per page. If nil, Discord's default is used.
+func NewArchivedThreadIterator(session *Session, channelID Snowflake, threadType ArchivedThreadType, limit *int32) *ArchivedThreadIterator {
+	return &ArchivedThreadIterator{
+		Session:   session,
+		ChannelID: channelID,
+		Type:      threadType,
+		Limit:     limit,
+		index:     -1,
+		hasMore:   true,
+	}
+}
+
+// Next advances to the next thread, fetching the next page when the current one is exhausted.
+// Returns false when there are no more threads or a request fails, which is reported by Err.
+func (ati *ArchivedThreadIterator) Next(ctx context.Context) bool {
+	if ati.err != nil {
+		return false
+	}
+
+	ati.index++
+
+	for ati.index >= len(ati.threads) {
+		if !ati.hasMore {
+			return false
+		}
+
+		err := ati.fetch(ctx)
+		if err != nil {
+			ati.err = err
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// Thread returns the current thread.
+func (ati *ArchivedThreadIterator) Thread() *Channel {
+	if ati.index < 0 || ati.index >= len(ati.threads) {
+		return nil
+	}
+
+	return &ati.threads[ati.index]
+}
+
+// Member returns the thread member of the current user for the current thread, or nil if the
+// current user has not joined it.
+func (ati *ArchivedThreadIterator) Member() *ThreadMember {
+	thread := ati.Thread()
+	if thread == nil {
+		return nil
+	}
+
+	return ati.members[thread.ID]
+}
+
+// Err returns the error that stopped iteration, if any.
+func (ati *ArchivedThreadIterator) Err() error {
+	return ati.err
+}
+
+// fetch requests the page after the last thread returned.
+func (ati *ArchivedThreadIterator) fetch(ctx context.Context) error {
+	var response *ThreadsResponse
+
+	var err error
+
+	switch ati.Type {
+	case ArchivedThreadTypePrivate:
+		response, err = ListPrivateArchivedThreads(ctx, ati.Session, ati.ChannelID, ati.before, ati.Limit)
+	case ArchivedThreadTypeJoinedPrivate:
+		response, err = ListJoinedPrivateArchivedThreads(ctx, ati.Session, ati.ChannelID, ati.beforeID, ati.Limit)
+	default:
+		response, err = ListPublicArchivedThreads(ctx, ati.Session, ati.ChannelID, ati.before, ati.Limit)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ati.threads = nil
+	ati.index = 0
+	ati.hasMore = false
+
+	if response == nil || len(response.Threads) == 0 {
+		return nil
+	}
+
+	ati.threads = response.Threads
+	ati.hasMore = response.HasMore
+
+	ati.members = make(map[Snowflake]*ThreadMember, len(response.Members))
+	for i := range response.Members {
+		if response.Members[i].ID != nil {
+			ati.members[*response.Members[i].ID] = &response.Members[i]
+		}
+	}
+
+	// Public and private threads are paged by archive time, joined threads by ID.
+	last := response.Threads[len(response.Threads)-1]
+
+	if ati.Type == ArchivedThreadTypeJoinedPrivate {
+		ati.beforeID = &last.ID
+	} else {
+		if last.ThreadMetadata == nil {
+			ati.hasMore = false
+
+			return nil
+		}
+
+		ati.before = &last.ThreadMetadata.ArchiveTimestamp
+	}
+
+	return nil
+}
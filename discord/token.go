@@ -0,0 +1,259 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// token.go contains the token sources used to authorize requests made by a session.
+
+// TokenExpiryLeeway is how long before an access token expires that it is refreshed, so it does
+// not expire while a request is in flight.
+const TokenExpiryLeeway = time.Minute
+
+// TokenSource provides the value of the Authorization header sent with requests.
+type TokenSource interface {
+	// Token returns the value of the Authorization header, including its type.
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshableTokenSource is a token source whose token can be replaced after it is rejected.
+type RefreshableTokenSource interface {
+	TokenSource
+
+	// Refresh replaces the token if it is still the rejected one. This lets concurrent requests
+	// rejected with the same token only refresh it once.
+	Refresh(ctx context.Context, rejected string) error
+}
+
+// ScopedTokenSource is a token source that knows which OAuth2 scopes its token was granted.
+type ScopedTokenSource interface {
+	TokenSource
+
+	HasScope(scope OAuth2Scope) bool
+}
+
+// BotToken is a token source for a bot token. The Bot prefix is added if it is missing.
+type BotToken string
+
+// Token returns the bot token with the Bot prefix.
+func (bt BotToken) Token(_ context.Context) (string, error) {
+	return "Bot " + strings.TrimPrefix(string(bt), "Bot "), nil
+}
+
+// OAuth2TokenSource is a token source for an access token granted by a user. The access token is
+// refreshed when it expires or is rejected, rotating the refresh token.
+type OAuth2TokenSource struct {
+	Client *OAuth2Client
+
+	// OnRefresh is called with the new access token whenever it is refreshed, and should be
+	// used to store it, as the previous refresh token can no longer be used. It is called after
+	// the token source is unlocked, so it may use the token source.
+	OnRefresh func(accessToken *AccessToken)
+
+	accessToken *AccessToken
+
+	mu sync.Mutex
+}
+
+// NewOAuth2TokenSource creates a token source for a user's access token. Requests return
+// ErrNoAccessToken if the access token is nil.
+func NewOAuth2TokenSource(client *OAuth2Client, accessToken *AccessToken) *OAuth2TokenSource {
+	return &OAuth2TokenSource{
+		Client:      client,
+		accessToken: accessToken,
+	}
+}
+
+// Token returns the Authorization header for the access token, refreshing it if it has expired.
+func (ots *OAuth2TokenSource) Token(ctx context.Context) (string, error) {
+	accessToken, err := ots.AccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return accessToken.Authorization(), nil
+}
+
+// AccessToken returns the access token, refreshing it if it has expired.
+func (ots *OAuth2TokenSource) AccessToken(ctx context.Context) (*AccessToken, error) {
+	ots.mu.Lock()
+
+	if ots.accessToken == nil {
+		ots.mu.Unlock()
+
+		return nil, ErrNoAccessToken
+	}
+
+	var refreshed *AccessToken
+
+	if ots.accessToken.Expired(TokenExpiryLeeway) {
+		var err error
+
+		refreshed, err = ots.refreshLocked(ctx)
+		if err != nil {
+			ots.mu.Unlock()
+
+			return nil, err
+		}
+	}
+
+	accessToken := ots.accessToken
+
+	ots.mu.Unlock()
+
+	ots.onRefresh(refreshed)
+
+	return accessToken, nil
+}
+
+// Refresh refreshes the access token if it is still the rejected one.
+func (ots *OAuth2TokenSource) Refresh(ctx context.Context, rejected string) error {
+	ots.mu.Lock()
+
+	if ots.accessToken == nil {
+		ots.mu.Unlock()
+
+		return ErrNoAccessToken
+	}
+
+	if ots.accessToken.Authorization() != rejected {
+		ots.mu.Unlock()
+
+		return nil
+	}
+
+	refreshed, err := ots.refreshLocked(ctx)
+
+	ots.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	ots.onRefresh(refreshed)
+
+	return nil
+}
+
+// HasScope returns if the access token was granted a scope.
+func (ots *OAuth2TokenSource) HasScope(scope OAuth2Scope) bool {
+	ots.mu.Lock()
+	defer ots.mu.Unlock()
+
+	return ots.accessToken != nil && ots.accessToken.HasScope(scope)
+}
+
+// refreshLocked replaces the access token with a refreshed one and returns it.
+func (ots *OAuth2TokenSource) refreshLocked(ctx context.Context) (*AccessToken, error) {
+	if ots.accessToken.RefreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+
+	accessToken, err := ots.Client.RefreshToken(ctx, ots.accessToken.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if accessToken.RefreshToken == "" {
+		accessToken.RefreshToken = ots.accessToken.RefreshToken
+	}
+
+	ots.accessToken = accessToken
+
+	return accessToken, nil
+}
+
+// onRefresh calls OnRefresh with a refreshed access token. It must be called without the lock
+// held, so the callback can use the token source.
+func (ots *OAuth2TokenSource) onRefresh(accessToken *AccessToken) {
+	if accessToken != nil && ots.OnRefresh != nil {
+		ots.OnRefresh(accessToken)
+	}
+}
+
+// ClientCredentialsTokenSource is a token source for the user that owns an application, using
+// the client credentials grant. A new access token is requested when it expires or is rejected.
+type ClientCredentialsTokenSource struct {
+	Client *OAuth2Client
+
+	accessToken *AccessToken
+
+	Scopes []OAuth2Scope
+
+	mu sync.Mutex
+}
+
+// NewClientCredentialsTokenSource creates a token source for the owner of an application.
+func NewClientCredentialsTokenSource(client *OAuth2Client, scopes ...OAuth2Scope) *ClientCredentialsTokenSource {
+	return &ClientCredentialsTokenSource{
+		Client: client,
+		Scopes: scopes,
+	}
+}
+
+// Token returns the Authorization header for the access token, requesting one if it has expired.
+func (ccts *ClientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	ccts.mu.Lock()
+	defer ccts.mu.Unlock()
+
+	if ccts.accessToken == nil || ccts.accessToken.Expired(TokenExpiryLeeway) {
+		err := ccts.refresh(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return ccts.accessToken.Authorization(), nil
+}
+
+// Refresh requests a new access token if the current one is the rejected one.
+func (ccts *ClientCredentialsTokenSource) Refresh(ctx context.Context, rejected string) error {
+	ccts.mu.Lock()
+	defer ccts.mu.Unlock()
+
+	if ccts.accessToken != nil && ccts.accessToken.Authorization() != rejected {
+		return nil
+	}
+
+	return ccts.refresh(ctx)
+}
+
+// HasScope returns if the access token was granted a scope. Before the first request, this
+// returns if the scope will be requested.
+func (ccts *ClientCredentialsTokenSource) HasScope(scope OAuth2Scope) bool {
+	ccts.mu.Lock()
+	defer ccts.mu.Unlock()
+
+	if ccts.accessToken == nil {
+		return slices.Contains(ccts.Scopes, scope)
+	}
+
+	return ccts.accessToken.HasScope(scope)
+}
+
+func (ccts *ClientCredentialsTokenSource) refresh(ctx context.Context) error {
+	accessToken, err := ccts.Client.ClientCredentials(ctx, ccts.Scopes...)
+	if err != nil {
+		return err
+	}
+
+	ccts.accessToken = accessToken
+
+	return nil
+}
+
+// requireScope returns ErrMissingScope if the session's token is known to not have a scope.
+// Sessions that are not authorized with OAuth2 are not checked.
+func requireScope(session *Session, scope OAuth2Scope) error {
+	source, ok := session.TokenSource.(ScopedTokenSource)
+	if ok && !source.HasScope(scope) {
+		return fmt.Errorf("%w: %s", ErrMissingScope, scope)
+	}
+
+	return nil
+}
@@ -49,6 +49,17 @@ func (s Snowflake) MarshalJSON() ([]byte, error) {
 	return int64ToStringBytes(int64(s)), nil
 }
 
+// parseSnowflake parses a Snowflake from its decimal string form. Unlike UnmarshalJSON, values
+// such as null, quoted or empty strings are rejected.
+func parseSnowflake(value string) (Snowflake, error) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid snowflake %q: %w", value, err)
+	}
+
+	return Snowflake(id), nil
+}
+
 func (s Snowflake) String() string {
 	return strconv.FormatInt(int64(s), 10)
 }
@@ -139,16 +139,16 @@ type ClientUser User
 // avatar: File of new avatar to change to.
 func (u *ClientUser) Edit(ctx context.Context, session *Session, username *string, avatar *[]byte) error {
 	params := UserParam{
-		Username: username,
+		Username: OptionalFromPointer(username),
 	}
 
 	if avatar != nil {
-		avatarBase64, err := bytesToBase64Data(*avatar)
+		avatarBase64, err := EncodeImage(*avatar, ImageUsageAvatar)
 		if err != nil {
 			return err
 		}
 
-		params.Avatar = &avatarBase64
+		params.Avatar = NewNullable(avatarBase64)
 	}
 
 	newUser, err := ModifyCurrentUser(ctx, session, params)
@@ -163,8 +163,9 @@ func (u *ClientUser) Edit(ctx context.Context, session *Session, username *strin
 
 // UserParam represents the payload sent to modify a user.
 type UserParam struct {
-	Username *string `json:"username,omitempty"`
-	Avatar   *string `json:"avatar,omitempty"`
+	Username Optional[string] `json:"username,omitempty"`
+	Avatar   Nullable[string] `json:"avatar,omitempty"`
+	Banner   Nullable[string] `json:"banner,omitempty"`
 }
 
 // UserConnection represents a connected external account.
@@ -48,9 +48,14 @@ func ModifyCurrentUser(ctx context.Context, session *Session, userParam UserPara
 func GetCurrentUserGuilds(ctx context.Context, session *Session) ([]Guild, error) {
 	endpoint := EndpointUserGuilds("@me")
 
+	err := requireScope(session, OAuth2ScopeGuilds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user guilds: %w", err)
+	}
+
 	var guilds []Guild
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &guilds)
+	err = session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &guilds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current user guilds: %w", err)
 	}
@@ -103,9 +108,14 @@ func CreateDM(ctx context.Context, session *Session, recipientID Snowflake) (*Ch
 func GetCurrentUserConnections(ctx context.Context, session *Session) ([]UserConnection, error) {
 	endpoint := EndpointUserConnections
 
+	err := requireScope(session, OAuth2ScopeConnections)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user connections: %w", err)
+	}
+
 	var connections []UserConnection
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &connections)
+	err = session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &connections)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current user connections: %w", err)
 	}
@@ -117,9 +127,14 @@ func GetCurrentUserConnections(ctx context.Context, session *Session) ([]UserCon
 func GetCurrentUserApplicationRoleConnection(ctx context.Context, session *Session, applicationID Snowflake) (*ApplicationRoleConnection, error) {
 	endpoint := EndpointUserApplicationRoleConnection(applicationID.String())
 
+	err := requireScope(session, OAuth2ScopeRoleConnectionsWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user application role connection: %w", err)
+	}
+
 	var roleConnection *ApplicationRoleConnection
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &roleConnection)
+	err = session.Interface.FetchJJ(ctx, session, http.MethodGet, endpoint, nil, nil, &roleConnection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current user application role connection: %w", err)
 	}
@@ -131,9 +146,14 @@ func GetCurrentUserApplicationRoleConnection(ctx context.Context, session *Sessi
 func UpdateCurrentUserApplicationRoleConnection(ctx context.Context, session *Session, applicationID Snowflake, params ApplicationRoleConnection) (*ApplicationRoleConnection, error) {
 	endpoint := EndpointUserApplicationRoleConnection(applicationID.String())
 
+	err := requireScope(session, OAuth2ScopeRoleConnectionsWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update current user application role connection: %w", err)
+	}
+
 	var roleConnection *ApplicationRoleConnection
 
-	err := session.Interface.FetchJJ(ctx, session, http.MethodPut, endpoint, params, nil, &roleConnection)
+	err = session.Interface.FetchJJ(ctx, session, http.MethodPut, endpoint, params, nil, &roleConnection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update current user application role connection: %w", err)
 	}
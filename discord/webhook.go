@@ -42,16 +42,16 @@ func (w *Webhook) Delete(ctx context.Context, session *Session, reason *string)
 // reason: The reason for editing this webhook.
 func (w *Webhook) Edit(ctx context.Context, session *Session, name *string, avatar *[]byte, reason *string) error {
 	params := WebhookParam{
-		Name: name,
+		Name: OptionalFromPointer(name),
 	}
 
 	if avatar != nil {
-		avatarBase64, err := bytesToBase64Data(*avatar)
+		avatarBase64, err := EncodeImage(*avatar, ImageUsageAvatar)
 		if err != nil {
 			return err
 		}
 
-		params.Avatar = &avatarBase64
+		params.Avatar = NewNullable(avatarBase64)
 	}
 
 	var newWebhook *Webhook
@@ -123,8 +123,9 @@ type WebhookMessageParams struct {
 	TTS             bool                     `json:"tts,omitempty"`
 }
 
-// WebhookParam represents the data sent to discord to create a webhook.
+// WebhookParam represents the data sent to discord to create or modify a webhook.
 type WebhookParam struct {
-	Name   *string `json:"name,omitempty"`
-	Avatar *string `json:"avatar,omitempty"`
+	Name      Optional[string]    `json:"name,omitempty"`
+	Avatar    Nullable[string]    `json:"avatar,omitempty"`
+	ChannelID Optional[Snowflake] `json:"channel_id,omitempty"`
 }